@@ -46,7 +46,10 @@ func main() {
 		log.Logger.Fatalf("resolving P2P node multicast address error: %v", err)
 		return
 	}
-	peer := p2p.NewPeer(p2pMulticastAddr)
+	peer := p2p.NewPeer(
+		p2pMulticastAddr,
+		time.Duration(config.Config.P2P.ReclaimTimeout)*time.Millisecond,
+		config.Config.P2P.MapsDir)
 	defer func() {
 		err := peer.Close()
 		if err != nil {
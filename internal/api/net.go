@@ -45,6 +45,18 @@ func (server *Server) sendGameState(stateDto dto.GameStateDto, addr *net.UDPAddr
 	server.sendProto(protocol.NewGameState(stateDto), addr)
 }
 
+func (server *Server) sendBotList(robots []dto.RobotDto, addr *net.UDPAddr) {
+	server.sendProto(protocol.NewBotList(robots), addr)
+}
+
+func (server *Server) sendMapList(maps []dto.MapDto, addr *net.UDPAddr) {
+	server.sendProto(protocol.NewMapList(maps), addr)
+}
+
+func (server *Server) sendStats(stats []dto.PlayerStatsDto, addr *net.UDPAddr) {
+	server.sendProto(protocol.NewStats(stats), addr)
+}
+
 func (server *Server) sendGameList(games []dto.GameInfoDto, addr *net.UDPAddr) {
 	server.sendProto(protocol.NewGameList(games), addr)
 }
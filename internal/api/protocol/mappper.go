@@ -1,8 +1,11 @@
 package protocol
 
 import (
+	"sort"
+
 	"google.golang.org/protobuf/proto"
 
+	"p2p-snake/internal/p2p/bot"
 	"p2p-snake/internal/p2p/dto"
 	"p2p-snake/internal/p2p/protocol"
 )
@@ -40,9 +43,22 @@ func NewGameState(stateDto dto.GameStateDto) *APIResponse {
 	return &APIResponse{
 		Type: &APIResponse_GameState{
 			GameState: &APIResponse_GameStateMsg{
-				Snakes:  mapToP2PSnakes(stateDto.Snakes),
-				Foods:   mapToCoords(stateDto.Foods),
-				Players: mapToPlayers(stateDto.Players),
+				Snakes:     mapToP2PSnakes(stateDto.Snakes),
+				Foods:      mapToCoords(stateDto.Foods),
+				Items:      mapToItems(stateDto.Items),
+				FoodKinds:  mapToFoodKinds(stateDto.FoodKinds),
+				Players:    mapToPlayers(stateDto.Players),
+				StateOrder: proto.Int32(stateDto.StateOrder),
+				Events:     mapToEvents(stateDto.Events),
+				Walled:     proto.Bool(stateDto.Config.Walled),
+				Walls:      mapToCoords(append(stateDto.Config.Walls, stateDto.ArenaWalls...)),
+				Result:     mapToResult(stateDto.Result),
+				Round:      proto.Int32(stateDto.Round),
+
+				ArenaLevel:      proto.Int32(stateDto.ArenaLevel),
+				NextShrinkOrder: proto.Int32(stateDto.NextShrinkOrder),
+
+				Teams: mapToTeams(stateDto.Teams),
 			},
 		},
 	}
@@ -58,6 +74,36 @@ func NewGameList(games []dto.GameInfoDto) *APIResponse {
 	}
 }
 
+func NewBotList(robots []dto.RobotDto) *APIResponse {
+	return &APIResponse{
+		Type: &APIResponse_BotList{
+			BotList: &APIResponse_BotListMsg{
+				Bots: mapToBots(robots),
+			},
+		},
+	}
+}
+
+func NewMapList(maps []dto.MapDto) *APIResponse {
+	return &APIResponse{
+		Type: &APIResponse_MapList{
+			MapList: &APIResponse_MapListMsg{
+				Maps: mapToMapInfos(maps),
+			},
+		},
+	}
+}
+
+func NewStats(stats []dto.PlayerStatsDto) *APIResponse {
+	return &APIResponse{
+		Type: &APIResponse_Stats{
+			Stats: &APIResponse_StatsMsg{
+				Players: mapToPlayerStats(stats),
+			},
+		},
+	}
+}
+
 func mapToP2PSnakes(snakeDtos []dto.SnakeDto) []*APIResponse_GameStateMsg_Snake {
 	snakes := make([]*APIResponse_GameStateMsg_Snake, len(snakeDtos))
 	for i, snakeDto := range snakeDtos {
@@ -65,11 +111,66 @@ func mapToP2PSnakes(snakeDtos []dto.SnakeDto) []*APIResponse_GameStateMsg_Snake
 			PlayerId:      proto.Int32(snakeDto.PlayerId),
 			HeadDirection: (*Direction)(proto.Int32((int32)(MapToP2PDirection(Direction(snakeDto.HeadDirection))))),
 			Points:        mapToCoords(snakeDto.Points),
+			Effects:       mapToEffects(snakeDto.Effects),
 		}
 	}
 	return snakes
 }
 
+func mapToFoodKinds(kindDtos []dto.FoodKind) []APIResponse_GameStateMsg_FoodKind {
+	kinds := make([]APIResponse_GameStateMsg_FoodKind, len(kindDtos))
+	for i, kindDto := range kindDtos {
+		switch kindDto {
+		case dto.GOLDEN_FOOD:
+			kinds[i] = APIResponse_GameStateMsg_GOLDEN_FOOD
+		case dto.POISON_FOOD:
+			kinds[i] = APIResponse_GameStateMsg_POISON_FOOD
+		default:
+			kinds[i] = APIResponse_GameStateMsg_NORMAL_FOOD
+		}
+	}
+	return kinds
+}
+
+func mapToItemKind(kindDto dto.ItemKind) *APIResponse_GameStateMsg_Item_Kind {
+	switch kindDto {
+	case dto.SPEED_BOOST:
+		return APIResponse_GameStateMsg_Item_SPEED_BOOST.Enum()
+	case dto.SHRINK:
+		return APIResponse_GameStateMsg_Item_SHRINK.Enum()
+	case dto.GHOST:
+		return APIResponse_GameStateMsg_Item_GHOST.Enum()
+	case dto.SCORE_MULTIPLIER:
+		return APIResponse_GameStateMsg_Item_SCORE_MULTIPLIER.Enum()
+	}
+	return nil
+}
+
+func mapToItems(itemDtos []dto.ItemDto) []*APIResponse_GameStateMsg_Item {
+	items := make([]*APIResponse_GameStateMsg_Item, len(itemDtos))
+	for i, itemDto := range itemDtos {
+		items[i] = &APIResponse_GameStateMsg_Item{
+			Kind: mapToItemKind(itemDto.Kind),
+			Coord: &APIResponse_GameStateMsg_Coord{
+				X: proto.Int32(itemDto.Coord.X),
+				Y: proto.Int32(itemDto.Coord.Y),
+			},
+		}
+	}
+	return items
+}
+
+func mapToEffects(effectDtos []dto.EffectDto) []*APIResponse_GameStateMsg_Effect {
+	effects := make([]*APIResponse_GameStateMsg_Effect, len(effectDtos))
+	for i, effectDto := range effectDtos {
+		effects[i] = &APIResponse_GameStateMsg_Effect{
+			Kind:           mapToItemKind(effectDto.Kind),
+			RemainingTurns: proto.Int32(effectDto.RemainingTurns),
+		}
+	}
+	return effects
+}
+
 func mapToDirection(p2pDirection protocol.Direction) Direction {
 	switch p2pDirection {
 	case protocol.Direction_UP:
@@ -98,6 +199,75 @@ func MapToP2PDirection(direction Direction) protocol.Direction {
 	return 0
 }
 
+func MapToStrategyName(difficulty BotDifficulty) string {
+	switch difficulty {
+	case BotDifficulty_EASY:
+		return bot.GreedyStrategyName
+	case BotDifficulty_HARD:
+		return bot.SurvivalStrategyName
+	}
+	return ""
+}
+
+func mapToBotDifficulty(strategyName string) *BotDifficulty {
+	switch strategyName {
+	case bot.GreedyStrategyName:
+		return BotDifficulty_EASY.Enum()
+	case bot.SurvivalStrategyName:
+		return BotDifficulty_HARD.Enum()
+	}
+	return nil
+}
+
+func mapToBots(robotDtos []dto.RobotDto) []*APIResponse_BotListMsg_Bot {
+	bots := make([]*APIResponse_BotListMsg_Bot, len(robotDtos))
+	for i, robotDto := range robotDtos {
+		bots[i] = &APIResponse_BotListMsg_Bot{
+			PlayerId:   proto.Int32(robotDto.PlayerId),
+			Name:       proto.String(robotDto.Name),
+			Score:      proto.Int32(robotDto.Score),
+			Difficulty: mapToBotDifficulty(robotDto.Strategy),
+			IsAlive:    proto.Bool(robotDto.IsAlive),
+		}
+	}
+	return bots
+}
+
+func MapToP2PGameConfig(request *APIRequest_CreateGameMsg) *protocol.GameConfig {
+	return &protocol.GameConfig{
+		Width:               proto.Int32(request.GetWidth()),
+		Height:              proto.Int32(request.GetHeight()),
+		FoodStatic:          proto.Int32(request.GetFoodStatic()),
+		StateDelayMs:        proto.Int32(request.GetStateDelayMs()),
+		Walled:              proto.Bool(request.GetWalled()),
+		Walls:               MapToP2PCoords(request.GetWalls()),
+		MaxTurns:            proto.Int32(request.GetMaxTurns()),
+		TargetScore:         proto.Int32(request.GetTargetScore()),
+		LastSnakeStanding:   proto.Bool(request.GetLastSnakeStanding()),
+		MaxItems:            proto.Int32(request.GetMaxItems()),
+		NormalFoodWeight:    proto.Int32(request.GetNormalFoodWeight()),
+		GoldenFoodWeight:    proto.Int32(request.GetGoldenFoodWeight()),
+		PoisonFoodWeight:    proto.Int32(request.GetPoisonFoodWeight()),
+		RespawnDelay:        proto.Int32(request.GetRespawnDelay()),
+		HalveScoreOnRespawn: proto.Bool(request.GetHalveScoreOnRespawn()),
+		ShrinkInterval:      proto.Int32(request.GetShrinkInterval()),
+		TeamCount:           proto.Int32(request.GetTeamCount()),
+		SafeTeammates:       proto.Bool(request.GetSafeTeammates()),
+		SpawnDistance:       proto.Int32(request.GetSpawnDistance()),
+	}
+}
+
+func MapToP2PCoords(coords []*APIResponse_GameStateMsg_Coord) []*protocol.GameState_Coord {
+	p2pCoords := make([]*protocol.GameState_Coord, len(coords))
+	for i, coord := range coords {
+		p2pCoords[i] = &protocol.GameState_Coord{
+			X: proto.Int32(coord.GetX()),
+			Y: proto.Int32(coord.GetY()),
+		}
+	}
+	return p2pCoords
+}
+
 func mapToCoords(coordDtos []dto.CoordDto) []*APIResponse_GameStateMsg_Coord {
 	coords := make([]*APIResponse_GameStateMsg_Coord, len(coordDtos))
 	for i, coordDto := range coordDtos {
@@ -132,10 +302,82 @@ func mapToPlayers(playerDtos []dto.PlayerDto) []*APIResponse_GameStateMsg_Player
 			Score: proto.Int32(playerDto.Score),
 			Role:  mapToNodeRole(playerDto.Role),
 		}
+		if playerDto.Team != 0 {
+			players[i].Team = proto.Int32(playerDto.Team)
+		}
 	}
 	return players
 }
 
+func mapToTeams(teamDtos []dto.TeamDto) []*APIResponse_GameStateMsg_Team {
+	teams := make([]*APIResponse_GameStateMsg_Team, len(teamDtos))
+	for i, teamDto := range teamDtos {
+		teams[i] = &APIResponse_GameStateMsg_Team{
+			Id:        proto.Int32(teamDto.Id),
+			Score:     proto.Int32(teamDto.Score),
+			PlayerIds: teamDto.PlayerIds,
+		}
+	}
+	return teams
+}
+
+func mapToEventType(eventTypeDto dto.EventType) *APIResponse_GameStateMsg_Event_Type {
+	switch eventTypeDto {
+	case dto.FOOD_EATEN:
+		return APIResponse_GameStateMsg_Event_FOOD_EATEN.Enum()
+	case dto.SNAKE_DIED:
+		return APIResponse_GameStateMsg_Event_SNAKE_DIED.Enum()
+	case dto.POINTS_AWARDED:
+		return APIResponse_GameStateMsg_Event_POINTS_AWARDED.Enum()
+	case dto.FOOD_SPAWNED:
+		return APIResponse_GameStateMsg_Event_FOOD_SPAWNED.Enum()
+	case dto.ZOMBIE_CREATED:
+		return APIResponse_GameStateMsg_Event_ZOMBIE_CREATED.Enum()
+	case dto.GAME_FINISHED:
+		return APIResponse_GameStateMsg_Event_GAME_FINISHED.Enum()
+	case dto.ITEM_SPAWNED:
+		return APIResponse_GameStateMsg_Event_ITEM_SPAWNED.Enum()
+	case dto.ITEM_PICKED_UP:
+		return APIResponse_GameStateMsg_Event_ITEM_PICKED_UP.Enum()
+	case dto.SNAKE_RESPAWNED:
+		return APIResponse_GameStateMsg_Event_SNAKE_RESPAWNED.Enum()
+	}
+	return nil
+}
+
+func mapToDeathCause(causeDto dto.DeathCause) *APIResponse_GameStateMsg_Event_DeathCause {
+	switch causeDto {
+	case dto.SELF:
+		return APIResponse_GameStateMsg_Event_SELF.Enum()
+	case dto.SNAKE:
+		return APIResponse_GameStateMsg_Event_SNAKE.Enum()
+	case dto.HEAD_ON:
+		return APIResponse_GameStateMsg_Event_HEAD_ON.Enum()
+	case dto.WALL:
+		return APIResponse_GameStateMsg_Event_WALL.Enum()
+	}
+	return nil
+}
+
+func mapToEvents(eventDtos []dto.EventDto) []*APIResponse_GameStateMsg_Event {
+	events := make([]*APIResponse_GameStateMsg_Event, len(eventDtos))
+	for i, eventDto := range eventDtos {
+		events[i] = &APIResponse_GameStateMsg_Event{
+			Type:     mapToEventType(eventDto.Type),
+			PlayerId: proto.Int32(eventDto.PlayerId),
+			Coord: &APIResponse_GameStateMsg_Coord{
+				X: proto.Int32(eventDto.Coord.X),
+				Y: proto.Int32(eventDto.Coord.Y),
+			},
+			Cause:     mapToDeathCause(eventDto.Cause),
+			KillerIds: eventDto.KillerIds,
+			Points:    proto.Int32(eventDto.Points),
+			Item:      mapToItemKind(eventDto.Item),
+		}
+	}
+	return events
+}
+
 func mapToGameInfos(gameInfoDtos []dto.GameInfoDto) []*APIResponse_GameListMsg_GameInfo {
 	games := make([]*APIResponse_GameListMsg_GameInfo, len(gameInfoDtos))
 	for i, gameInfoDto := range gameInfoDtos {
@@ -144,7 +386,113 @@ func mapToGameInfos(gameInfoDtos []dto.GameInfoDto) []*APIResponse_GameListMsg_G
 			Width:      proto.Int32(gameInfoDto.Width),
 			Height:     proto.Int32(gameInfoDto.Height),
 			StateDelay: proto.Int32(gameInfoDto.StateDelay),
+			BotCount:   proto.Int32(gameInfoDto.BotCount),
+			Walled:     proto.Bool(gameInfoDto.Walled),
+			Teams:      mapToTeamRosters(gameInfoDto.Teams),
+			CanJoin:    proto.Bool(gameInfoDto.CanJoin),
 		}
 	}
 	return games
 }
+
+func mapToTeamRosters(rosterDtos []dto.TeamRosterDto) []*APIResponse_GameListMsg_Team {
+	teams := make([]*APIResponse_GameListMsg_Team, len(rosterDtos))
+	for i, rosterDto := range rosterDtos {
+		teams[i] = &APIResponse_GameListMsg_Team{
+			Id:          proto.Int32(rosterDto.Id),
+			PlayerNames: rosterDto.PlayerNames,
+		}
+	}
+	return teams
+}
+
+func mapToMapInfos(mapDtos []dto.MapDto) []*APIResponse_MapListMsg_MapInfo {
+	maps := make([]*APIResponse_MapListMsg_MapInfo, len(mapDtos))
+	for i, mapDto := range mapDtos {
+		maps[i] = &APIResponse_MapListMsg_MapInfo{
+			Name:   proto.String(mapDto.Name),
+			Width:  proto.Int32(mapDto.Width),
+			Height: proto.Int32(mapDto.Height),
+			Walled: proto.Bool(mapDto.Walled),
+		}
+	}
+	return maps
+}
+
+func mapToFinishReason(reasonDto dto.FinishReason) *APIResponse_GameStateMsg_Result_FinishReason {
+	switch reasonDto {
+	case dto.TURN_LIMIT:
+		return APIResponse_GameStateMsg_Result_TURN_LIMIT.Enum()
+	case dto.SCORE_LIMIT:
+		return APIResponse_GameStateMsg_Result_SCORE_LIMIT.Enum()
+	case dto.LAST_SNAKE:
+		return APIResponse_GameStateMsg_Result_LAST_SNAKE.Enum()
+	}
+	return nil
+}
+
+func mapToStandings(standingDtos []dto.StandingDto) []*APIResponse_GameStateMsg_Result_Standing {
+	standings := make([]*APIResponse_GameStateMsg_Result_Standing, len(standingDtos))
+	for i, standingDto := range standingDtos {
+		standings[i] = &APIResponse_GameStateMsg_Result_Standing{
+			Place:    proto.Int32(standingDto.Place),
+			PlayerId: proto.Int32(standingDto.PlayerId),
+			Name:     proto.String(standingDto.Name),
+			Score:    proto.Int32(standingDto.Score),
+		}
+	}
+	return standings
+}
+
+func mapToResult(resultDto *dto.ResultDto) *APIResponse_GameStateMsg_Result {
+	if resultDto == nil {
+		return nil
+	}
+
+	result := &APIResponse_GameStateMsg_Result{
+		Reason:    mapToFinishReason(resultDto.Reason),
+		Standings: mapToStandings(resultDto.Standings),
+	}
+	if resultDto.WinnerId != 0 {
+		result.WinnerId = proto.Int32(resultDto.WinnerId)
+	}
+	return result
+}
+
+func mapToDeathCounts(deathCauses map[dto.DeathCause]int32) []*APIResponse_StatsMsg_DeathCount {
+	causes := make([]dto.DeathCause, 0, len(deathCauses))
+	for cause := range deathCauses {
+		causes = append(causes, cause)
+	}
+	sort.Slice(causes, func(i, j int) bool { return causes[i] < causes[j] })
+
+	deathCounts := make([]*APIResponse_StatsMsg_DeathCount, 0, len(causes))
+	for _, cause := range causes {
+		if apiCause := mapToDeathCause(cause); apiCause != nil {
+			deathCounts = append(deathCounts, &APIResponse_StatsMsg_DeathCount{
+				Cause: apiCause,
+				Count: proto.Int32(deathCauses[cause]),
+			})
+		}
+	}
+	return deathCounts
+}
+
+func mapToPlayerStats(statsDtos []dto.PlayerStatsDto) []*APIResponse_StatsMsg_PlayerStats {
+	stats := make([]*APIResponse_StatsMsg_PlayerStats, len(statsDtos))
+	for i, statsDto := range statsDtos {
+		stats[i] = &APIResponse_StatsMsg_PlayerStats{
+			PlayerId:      proto.Int32(statsDto.PlayerId),
+			Name:          proto.String(statsDto.Name),
+			Score:         proto.Int32(statsDto.Score),
+			FoodEaten:     proto.Int32(statsDto.FoodEaten),
+			Kills:         proto.Int32(statsDto.Kills),
+			Deaths:        proto.Int32(statsDto.Deaths),
+			DeathCauses:   mapToDeathCounts(statsDto.DeathCauses),
+			MaxLength:     proto.Int32(statsDto.MaxLength),
+			TicksSurvived: proto.Int32(statsDto.TicksSurvived),
+			Turns:         proto.Int32(statsDto.Turns),
+		}
+	}
+	return stats
+}
@@ -83,6 +83,62 @@ func (Direction) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{0}
 }
 
+type BotDifficulty int32
+
+const (
+	BotDifficulty_EASY BotDifficulty = 1
+	BotDifficulty_HARD BotDifficulty = 2
+)
+
+// Enum value maps for BotDifficulty.
+var (
+	BotDifficulty_name = map[int32]string{
+		1: "EASY",
+		2: "HARD",
+	}
+	BotDifficulty_value = map[string]int32{
+		"EASY": 1,
+		"HARD": 2,
+	}
+)
+
+func (x BotDifficulty) Enum() *BotDifficulty {
+	p := new(BotDifficulty)
+	*p = x
+	return p
+}
+
+func (x BotDifficulty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BotDifficulty) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[1].Descriptor()
+}
+
+func (BotDifficulty) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[1]
+}
+
+func (x BotDifficulty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *BotDifficulty) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = BotDifficulty(num)
+	return nil
+}
+
+// Deprecated: Use BotDifficulty.Descriptor instead.
+func (BotDifficulty) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1}
+}
+
 type APIResponse_GameStateMsg_Role int32
 
 const (
@@ -119,11 +175,11 @@ func (x APIResponse_GameStateMsg_Role) String() string {
 }
 
 func (APIResponse_GameStateMsg_Role) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_enumTypes[1].Descriptor()
+	return file_api_proto_enumTypes[2].Descriptor()
 }
 
 func (APIResponse_GameStateMsg_Role) Type() protoreflect.EnumType {
-	return &file_api_proto_enumTypes[1]
+	return &file_api_proto_enumTypes[2]
 }
 
 func (x APIResponse_GameStateMsg_Role) Number() protoreflect.EnumNumber {
@@ -145,6 +201,325 @@ func (APIResponse_GameStateMsg_Role) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{1, 4, 0}
 }
 
+type APIResponse_GameStateMsg_FoodKind int32
+
+const (
+	APIResponse_GameStateMsg_NORMAL_FOOD APIResponse_GameStateMsg_FoodKind = 0
+	APIResponse_GameStateMsg_GOLDEN_FOOD APIResponse_GameStateMsg_FoodKind = 1
+	APIResponse_GameStateMsg_POISON_FOOD APIResponse_GameStateMsg_FoodKind = 2
+)
+
+// Enum value maps for APIResponse_GameStateMsg_FoodKind.
+var (
+	APIResponse_GameStateMsg_FoodKind_name = map[int32]string{
+		0: "NORMAL_FOOD",
+		1: "GOLDEN_FOOD",
+		2: "POISON_FOOD",
+	}
+	APIResponse_GameStateMsg_FoodKind_value = map[string]int32{
+		"NORMAL_FOOD": 0,
+		"GOLDEN_FOOD": 1,
+		"POISON_FOOD": 2,
+	}
+)
+
+func (x APIResponse_GameStateMsg_FoodKind) Enum() *APIResponse_GameStateMsg_FoodKind {
+	p := new(APIResponse_GameStateMsg_FoodKind)
+	*p = x
+	return p
+}
+
+func (x APIResponse_GameStateMsg_FoodKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (APIResponse_GameStateMsg_FoodKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[3].Descriptor()
+}
+
+func (APIResponse_GameStateMsg_FoodKind) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[3]
+}
+
+func (x APIResponse_GameStateMsg_FoodKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *APIResponse_GameStateMsg_FoodKind) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = APIResponse_GameStateMsg_FoodKind(num)
+	return nil
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_FoodKind.Descriptor instead.
+func (APIResponse_GameStateMsg_FoodKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 1}
+}
+
+type APIResponse_GameStateMsg_Item_Kind int32
+
+const (
+	APIResponse_GameStateMsg_Item_SPEED_BOOST      APIResponse_GameStateMsg_Item_Kind = 0
+	APIResponse_GameStateMsg_Item_SHRINK           APIResponse_GameStateMsg_Item_Kind = 1
+	APIResponse_GameStateMsg_Item_GHOST            APIResponse_GameStateMsg_Item_Kind = 2
+	APIResponse_GameStateMsg_Item_SCORE_MULTIPLIER APIResponse_GameStateMsg_Item_Kind = 3
+)
+
+// Enum value maps for APIResponse_GameStateMsg_Item_Kind.
+var (
+	APIResponse_GameStateMsg_Item_Kind_name = map[int32]string{
+		0: "SPEED_BOOST",
+		1: "SHRINK",
+		2: "GHOST",
+		3: "SCORE_MULTIPLIER",
+	}
+	APIResponse_GameStateMsg_Item_Kind_value = map[string]int32{
+		"SPEED_BOOST":      0,
+		"SHRINK":           1,
+		"GHOST":            2,
+		"SCORE_MULTIPLIER": 3,
+	}
+)
+
+func (x APIResponse_GameStateMsg_Item_Kind) Enum() *APIResponse_GameStateMsg_Item_Kind {
+	p := new(APIResponse_GameStateMsg_Item_Kind)
+	*p = x
+	return p
+}
+
+func (x APIResponse_GameStateMsg_Item_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (APIResponse_GameStateMsg_Item_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[4].Descriptor()
+}
+
+func (APIResponse_GameStateMsg_Item_Kind) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[4]
+}
+
+func (x APIResponse_GameStateMsg_Item_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *APIResponse_GameStateMsg_Item_Kind) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = APIResponse_GameStateMsg_Item_Kind(num)
+	return nil
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Item_Kind.Descriptor instead.
+func (APIResponse_GameStateMsg_Item_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 1, 0}
+}
+
+type APIResponse_GameStateMsg_Event_Type int32
+
+const (
+	APIResponse_GameStateMsg_Event_FOOD_EATEN      APIResponse_GameStateMsg_Event_Type = 0
+	APIResponse_GameStateMsg_Event_SNAKE_DIED      APIResponse_GameStateMsg_Event_Type = 1
+	APIResponse_GameStateMsg_Event_POINTS_AWARDED  APIResponse_GameStateMsg_Event_Type = 2
+	APIResponse_GameStateMsg_Event_FOOD_SPAWNED    APIResponse_GameStateMsg_Event_Type = 3
+	APIResponse_GameStateMsg_Event_ZOMBIE_CREATED  APIResponse_GameStateMsg_Event_Type = 4
+	APIResponse_GameStateMsg_Event_GAME_FINISHED   APIResponse_GameStateMsg_Event_Type = 5
+	APIResponse_GameStateMsg_Event_ITEM_SPAWNED    APIResponse_GameStateMsg_Event_Type = 6
+	APIResponse_GameStateMsg_Event_ITEM_PICKED_UP  APIResponse_GameStateMsg_Event_Type = 7
+	APIResponse_GameStateMsg_Event_SNAKE_RESPAWNED APIResponse_GameStateMsg_Event_Type = 8
+)
+
+// Enum value maps for APIResponse_GameStateMsg_Event_Type.
+var (
+	APIResponse_GameStateMsg_Event_Type_name = map[int32]string{
+		0: "FOOD_EATEN",
+		1: "SNAKE_DIED",
+		2: "POINTS_AWARDED",
+		3: "FOOD_SPAWNED",
+		4: "ZOMBIE_CREATED",
+		5: "GAME_FINISHED",
+		6: "ITEM_SPAWNED",
+		7: "ITEM_PICKED_UP",
+		8: "SNAKE_RESPAWNED",
+	}
+	APIResponse_GameStateMsg_Event_Type_value = map[string]int32{
+		"FOOD_EATEN":      0,
+		"SNAKE_DIED":      1,
+		"POINTS_AWARDED":  2,
+		"FOOD_SPAWNED":    3,
+		"ZOMBIE_CREATED":  4,
+		"GAME_FINISHED":   5,
+		"ITEM_SPAWNED":    6,
+		"ITEM_PICKED_UP":  7,
+		"SNAKE_RESPAWNED": 8,
+	}
+)
+
+func (x APIResponse_GameStateMsg_Event_Type) Enum() *APIResponse_GameStateMsg_Event_Type {
+	p := new(APIResponse_GameStateMsg_Event_Type)
+	*p = x
+	return p
+}
+
+func (x APIResponse_GameStateMsg_Event_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (APIResponse_GameStateMsg_Event_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[5].Descriptor()
+}
+
+func (APIResponse_GameStateMsg_Event_Type) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[5]
+}
+
+func (x APIResponse_GameStateMsg_Event_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *APIResponse_GameStateMsg_Event_Type) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = APIResponse_GameStateMsg_Event_Type(num)
+	return nil
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Event_Type.Descriptor instead.
+func (APIResponse_GameStateMsg_Event_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 6, 0}
+}
+
+type APIResponse_GameStateMsg_Event_DeathCause int32
+
+const (
+	APIResponse_GameStateMsg_Event_SELF    APIResponse_GameStateMsg_Event_DeathCause = 0
+	APIResponse_GameStateMsg_Event_SNAKE   APIResponse_GameStateMsg_Event_DeathCause = 1
+	APIResponse_GameStateMsg_Event_HEAD_ON APIResponse_GameStateMsg_Event_DeathCause = 2
+	APIResponse_GameStateMsg_Event_WALL    APIResponse_GameStateMsg_Event_DeathCause = 3
+)
+
+// Enum value maps for APIResponse_GameStateMsg_Event_DeathCause.
+var (
+	APIResponse_GameStateMsg_Event_DeathCause_name = map[int32]string{
+		0: "SELF",
+		1: "SNAKE",
+		2: "HEAD_ON",
+		3: "WALL",
+	}
+	APIResponse_GameStateMsg_Event_DeathCause_value = map[string]int32{
+		"SELF":    0,
+		"SNAKE":   1,
+		"HEAD_ON": 2,
+		"WALL":    3,
+	}
+)
+
+func (x APIResponse_GameStateMsg_Event_DeathCause) Enum() *APIResponse_GameStateMsg_Event_DeathCause {
+	p := new(APIResponse_GameStateMsg_Event_DeathCause)
+	*p = x
+	return p
+}
+
+func (x APIResponse_GameStateMsg_Event_DeathCause) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (APIResponse_GameStateMsg_Event_DeathCause) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[6].Descriptor()
+}
+
+func (APIResponse_GameStateMsg_Event_DeathCause) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[6]
+}
+
+func (x APIResponse_GameStateMsg_Event_DeathCause) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *APIResponse_GameStateMsg_Event_DeathCause) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = APIResponse_GameStateMsg_Event_DeathCause(num)
+	return nil
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Event_DeathCause.Descriptor instead.
+func (APIResponse_GameStateMsg_Event_DeathCause) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 6, 1}
+}
+
+type APIResponse_GameStateMsg_Result_FinishReason int32
+
+const (
+	APIResponse_GameStateMsg_Result_TURN_LIMIT  APIResponse_GameStateMsg_Result_FinishReason = 0
+	APIResponse_GameStateMsg_Result_SCORE_LIMIT APIResponse_GameStateMsg_Result_FinishReason = 1
+	APIResponse_GameStateMsg_Result_LAST_SNAKE  APIResponse_GameStateMsg_Result_FinishReason = 2
+)
+
+// Enum value maps for APIResponse_GameStateMsg_Result_FinishReason.
+var (
+	APIResponse_GameStateMsg_Result_FinishReason_name = map[int32]string{
+		0: "TURN_LIMIT",
+		1: "SCORE_LIMIT",
+		2: "LAST_SNAKE",
+	}
+	APIResponse_GameStateMsg_Result_FinishReason_value = map[string]int32{
+		"TURN_LIMIT":  0,
+		"SCORE_LIMIT": 1,
+		"LAST_SNAKE":  2,
+	}
+)
+
+func (x APIResponse_GameStateMsg_Result_FinishReason) Enum() *APIResponse_GameStateMsg_Result_FinishReason {
+	p := new(APIResponse_GameStateMsg_Result_FinishReason)
+	*p = x
+	return p
+}
+
+func (x APIResponse_GameStateMsg_Result_FinishReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (APIResponse_GameStateMsg_Result_FinishReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[7].Descriptor()
+}
+
+func (APIResponse_GameStateMsg_Result_FinishReason) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[7]
+}
+
+func (x APIResponse_GameStateMsg_Result_FinishReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *APIResponse_GameStateMsg_Result_FinishReason) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = APIResponse_GameStateMsg_Result_FinishReason(num)
+	return nil
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Result_FinishReason.Descriptor instead.
+func (APIResponse_GameStateMsg_Result_FinishReason) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 7, 0}
+}
+
 type APIRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -160,6 +535,12 @@ type APIRequest struct {
 	//	*APIRequest_GetGameState
 	//	*APIRequest_ExitGame
 	//	*APIRequest_Disconnect
+	//	*APIRequest_AddBots
+	//	*APIRequest_ListBots
+	//	*APIRequest_RemoveBot
+	//	*APIRequest_ListMaps
+	//	*APIRequest_RestartRound
+	//	*APIRequest_GetStats
 	Type isAPIRequest_Type `protobuf_oneof:"Type"`
 }
 
@@ -265,6 +646,48 @@ func (x *APIRequest) GetDisconnect() *APIRequest_DisconnectMsg {
 	return nil
 }
 
+func (x *APIRequest) GetAddBots() *APIRequest_AddBotsMsg {
+	if x, ok := x.GetType().(*APIRequest_AddBots); ok {
+		return x.AddBots
+	}
+	return nil
+}
+
+func (x *APIRequest) GetListBots() *APIRequest_ListBotsMsg {
+	if x, ok := x.GetType().(*APIRequest_ListBots); ok {
+		return x.ListBots
+	}
+	return nil
+}
+
+func (x *APIRequest) GetRemoveBot() *APIRequest_RemoveBotMsg {
+	if x, ok := x.GetType().(*APIRequest_RemoveBot); ok {
+		return x.RemoveBot
+	}
+	return nil
+}
+
+func (x *APIRequest) GetListMaps() *APIRequest_ListMapsMsg {
+	if x, ok := x.GetType().(*APIRequest_ListMaps); ok {
+		return x.ListMaps
+	}
+	return nil
+}
+
+func (x *APIRequest) GetRestartRound() *APIRequest_RestartRoundMsg {
+	if x, ok := x.GetType().(*APIRequest_RestartRound); ok {
+		return x.RestartRound
+	}
+	return nil
+}
+
+func (x *APIRequest) GetGetStats() *APIRequest_GetStatsMsg {
+	if x, ok := x.GetType().(*APIRequest_GetStats); ok {
+		return x.GetStats
+	}
+	return nil
+}
+
 type isAPIRequest_Type interface {
 	isAPIRequest_Type()
 }
@@ -305,6 +728,30 @@ type APIRequest_Disconnect struct {
 	Disconnect *APIRequest_DisconnectMsg `protobuf:"bytes,9,opt,name=disconnect,oneof"`
 }
 
+type APIRequest_AddBots struct {
+	AddBots *APIRequest_AddBotsMsg `protobuf:"bytes,10,opt,name=add_bots,json=addBots,oneof"`
+}
+
+type APIRequest_ListBots struct {
+	ListBots *APIRequest_ListBotsMsg `protobuf:"bytes,11,opt,name=list_bots,json=listBots,oneof"`
+}
+
+type APIRequest_RemoveBot struct {
+	RemoveBot *APIRequest_RemoveBotMsg `protobuf:"bytes,12,opt,name=remove_bot,json=removeBot,oneof"`
+}
+
+type APIRequest_ListMaps struct {
+	ListMaps *APIRequest_ListMapsMsg `protobuf:"bytes,13,opt,name=list_maps,json=listMaps,oneof"`
+}
+
+type APIRequest_RestartRound struct {
+	RestartRound *APIRequest_RestartRoundMsg `protobuf:"bytes,14,opt,name=restart_round,json=restartRound,oneof"`
+}
+
+type APIRequest_GetStats struct {
+	GetStats *APIRequest_GetStatsMsg `protobuf:"bytes,15,opt,name=get_stats,json=getStats,oneof"`
+}
+
 func (*APIRequest_Connect) isAPIRequest_Type() {}
 
 func (*APIRequest_Ping) isAPIRequest_Type() {}
@@ -323,6 +770,18 @@ func (*APIRequest_ExitGame) isAPIRequest_Type() {}
 
 func (*APIRequest_Disconnect) isAPIRequest_Type() {}
 
+func (*APIRequest_AddBots) isAPIRequest_Type() {}
+
+func (*APIRequest_ListBots) isAPIRequest_Type() {}
+
+func (*APIRequest_RemoveBot) isAPIRequest_Type() {}
+
+func (*APIRequest_ListMaps) isAPIRequest_Type() {}
+
+func (*APIRequest_RestartRound) isAPIRequest_Type() {}
+
+func (*APIRequest_GetStats) isAPIRequest_Type() {}
+
 type APIResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -334,6 +793,9 @@ type APIResponse struct {
 	//	*APIResponse_Error
 	//	*APIResponse_GameList
 	//	*APIResponse_GameState
+	//	*APIResponse_BotList
+	//	*APIResponse_MapList
+	//	*APIResponse_Stats
 	Type isAPIResponse_Type `protobuf_oneof:"Type"`
 }
 
@@ -411,16 +873,37 @@ func (x *APIResponse) GetGameState() *APIResponse_GameStateMsg {
 	return nil
 }
 
-type isAPIResponse_Type interface {
-	isAPIResponse_Type()
+func (x *APIResponse) GetBotList() *APIResponse_BotListMsg {
+	if x, ok := x.GetType().(*APIResponse_BotList); ok {
+		return x.BotList
+	}
+	return nil
 }
 
-type APIResponse_SuccessConnect struct {
-	SuccessConnect *APIResponse_SuccessConnectMsg `protobuf:"bytes,1,opt,name=successConnect,oneof"`
+func (x *APIResponse) GetMapList() *APIResponse_MapListMsg {
+	if x, ok := x.GetType().(*APIResponse_MapList); ok {
+		return x.MapList
+	}
+	return nil
 }
 
-type APIResponse_Ack struct {
-	Ack *APIResponse_AckMsg `protobuf:"bytes,2,opt,name=ack,oneof"`
+func (x *APIResponse) GetStats() *APIResponse_StatsMsg {
+	if x, ok := x.GetType().(*APIResponse_Stats); ok {
+		return x.Stats
+	}
+	return nil
+}
+
+type isAPIResponse_Type interface {
+	isAPIResponse_Type()
+}
+
+type APIResponse_SuccessConnect struct {
+	SuccessConnect *APIResponse_SuccessConnectMsg `protobuf:"bytes,1,opt,name=successConnect,oneof"`
+}
+
+type APIResponse_Ack struct {
+	Ack *APIResponse_AckMsg `protobuf:"bytes,2,opt,name=ack,oneof"`
 }
 
 type APIResponse_Error struct {
@@ -435,6 +918,18 @@ type APIResponse_GameState struct {
 	GameState *APIResponse_GameStateMsg `protobuf:"bytes,5,opt,name=game_state,json=gameState,oneof"`
 }
 
+type APIResponse_BotList struct {
+	BotList *APIResponse_BotListMsg `protobuf:"bytes,6,opt,name=bot_list,json=botList,oneof"`
+}
+
+type APIResponse_MapList struct {
+	MapList *APIResponse_MapListMsg `protobuf:"bytes,7,opt,name=map_list,json=mapList,oneof"`
+}
+
+type APIResponse_Stats struct {
+	Stats *APIResponse_StatsMsg `protobuf:"bytes,8,opt,name=stats,oneof"`
+}
+
 func (*APIResponse_SuccessConnect) isAPIResponse_Type() {}
 
 func (*APIResponse_Ack) isAPIResponse_Type() {}
@@ -445,6 +940,12 @@ func (*APIResponse_GameList) isAPIResponse_Type() {}
 
 func (*APIResponse_GameState) isAPIResponse_Type() {}
 
+func (*APIResponse_BotList) isAPIResponse_Type() {}
+
+func (*APIResponse_MapList) isAPIResponse_Type() {}
+
+func (*APIResponse_Stats) isAPIResponse_Type() {}
+
 type APIRequest_ConnectMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -535,14 +1036,36 @@ type APIRequest_CreateGameMsg struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token        *string `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
-	PlayerName   *string `protobuf:"bytes,2,req,name=player_name,json=playerName" json:"player_name,omitempty"`
-	GameName     *string `protobuf:"bytes,3,req,name=game_name,json=gameName" json:"game_name,omitempty"`
-	Width        *int32  `protobuf:"varint,4,req,name=width" json:"width,omitempty"`
-	Height       *int32  `protobuf:"varint,5,req,name=height" json:"height,omitempty"`
-	FoodStatic   *int32  `protobuf:"varint,6,req,name=food_static,json=foodStatic" json:"food_static,omitempty"`
-	StateDelayMs *int32  `protobuf:"varint,7,req,name=state_delay_ms,json=stateDelayMs" json:"state_delay_ms,omitempty"`
-}
+	Token               *string                           `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	PlayerName          *string                           `protobuf:"bytes,2,req,name=player_name,json=playerName" json:"player_name,omitempty"`
+	GameName            *string                           `protobuf:"bytes,3,req,name=game_name,json=gameName" json:"game_name,omitempty"`
+	Width               *int32                            `protobuf:"varint,4,req,name=width" json:"width,omitempty"`
+	Height              *int32                            `protobuf:"varint,5,req,name=height" json:"height,omitempty"`
+	FoodStatic          *int32                            `protobuf:"varint,6,req,name=food_static,json=foodStatic" json:"food_static,omitempty"`
+	StateDelayMs        *int32                            `protobuf:"varint,7,req,name=state_delay_ms,json=stateDelayMs" json:"state_delay_ms,omitempty"`
+	Walled              *bool                             `protobuf:"varint,8,opt,name=walled" json:"walled,omitempty"`
+	Walls               []*APIResponse_GameStateMsg_Coord `protobuf:"bytes,9,rep,name=walls" json:"walls,omitempty"`
+	MapName             *string                           `protobuf:"bytes,10,opt,name=map_name,json=mapName" json:"map_name,omitempty"`
+	MaxTurns            *int32                            `protobuf:"varint,11,opt,name=max_turns,json=maxTurns" json:"max_turns,omitempty"`
+	TargetScore         *int32                            `protobuf:"varint,12,opt,name=target_score,json=targetScore" json:"target_score,omitempty"`
+	LastSnakeStanding   *bool                             `protobuf:"varint,13,opt,name=last_snake_standing,json=lastSnakeStanding" json:"last_snake_standing,omitempty"`
+	MaxItems            *int32                            `protobuf:"varint,14,opt,name=max_items,json=maxItems" json:"max_items,omitempty"`
+	NormalFoodWeight    *int32                            `protobuf:"varint,15,opt,name=normal_food_weight,json=normalFoodWeight,def=1" json:"normal_food_weight,omitempty"`
+	GoldenFoodWeight    *int32                            `protobuf:"varint,16,opt,name=golden_food_weight,json=goldenFoodWeight" json:"golden_food_weight,omitempty"`
+	PoisonFoodWeight    *int32                            `protobuf:"varint,17,opt,name=poison_food_weight,json=poisonFoodWeight" json:"poison_food_weight,omitempty"`
+	RespawnDelay        *int32                            `protobuf:"varint,18,opt,name=respawn_delay,json=respawnDelay" json:"respawn_delay,omitempty"`
+	HalveScoreOnRespawn *bool                             `protobuf:"varint,19,opt,name=halve_score_on_respawn,json=halveScoreOnRespawn" json:"halve_score_on_respawn,omitempty"`
+	ShrinkInterval      *int32                            `protobuf:"varint,20,opt,name=shrink_interval,json=shrinkInterval" json:"shrink_interval,omitempty"`
+	TeamCount           *int32                            `protobuf:"varint,21,opt,name=team_count,json=teamCount" json:"team_count,omitempty"`
+	SafeTeammates       *bool                             `protobuf:"varint,22,opt,name=safe_teammates,json=safeTeammates" json:"safe_teammates,omitempty"`
+	SpawnDistance       *int32                            `protobuf:"varint,23,opt,name=spawn_distance,json=spawnDistance,def=4" json:"spawn_distance,omitempty"`
+}
+
+// Default values for APIRequest_CreateGameMsg fields.
+const (
+	Default_APIRequest_CreateGameMsg_NormalFoodWeight = int32(1)
+	Default_APIRequest_CreateGameMsg_SpawnDistance    = int32(4)
+)
 
 func (x *APIRequest_CreateGameMsg) Reset() {
 	*x = APIRequest_CreateGameMsg{}
@@ -625,6 +1148,118 @@ func (x *APIRequest_CreateGameMsg) GetStateDelayMs() int32 {
 	return 0
 }
 
+func (x *APIRequest_CreateGameMsg) GetWalled() bool {
+	if x != nil && x.Walled != nil {
+		return *x.Walled
+	}
+	return false
+}
+
+func (x *APIRequest_CreateGameMsg) GetWalls() []*APIResponse_GameStateMsg_Coord {
+	if x != nil {
+		return x.Walls
+	}
+	return nil
+}
+
+func (x *APIRequest_CreateGameMsg) GetMapName() string {
+	if x != nil && x.MapName != nil {
+		return *x.MapName
+	}
+	return ""
+}
+
+func (x *APIRequest_CreateGameMsg) GetMaxTurns() int32 {
+	if x != nil && x.MaxTurns != nil {
+		return *x.MaxTurns
+	}
+	return 0
+}
+
+func (x *APIRequest_CreateGameMsg) GetTargetScore() int32 {
+	if x != nil && x.TargetScore != nil {
+		return *x.TargetScore
+	}
+	return 0
+}
+
+func (x *APIRequest_CreateGameMsg) GetLastSnakeStanding() bool {
+	if x != nil && x.LastSnakeStanding != nil {
+		return *x.LastSnakeStanding
+	}
+	return false
+}
+
+func (x *APIRequest_CreateGameMsg) GetMaxItems() int32 {
+	if x != nil && x.MaxItems != nil {
+		return *x.MaxItems
+	}
+	return 0
+}
+
+func (x *APIRequest_CreateGameMsg) GetNormalFoodWeight() int32 {
+	if x != nil && x.NormalFoodWeight != nil {
+		return *x.NormalFoodWeight
+	}
+	return Default_APIRequest_CreateGameMsg_NormalFoodWeight
+}
+
+func (x *APIRequest_CreateGameMsg) GetGoldenFoodWeight() int32 {
+	if x != nil && x.GoldenFoodWeight != nil {
+		return *x.GoldenFoodWeight
+	}
+	return 0
+}
+
+func (x *APIRequest_CreateGameMsg) GetPoisonFoodWeight() int32 {
+	if x != nil && x.PoisonFoodWeight != nil {
+		return *x.PoisonFoodWeight
+	}
+	return 0
+}
+
+func (x *APIRequest_CreateGameMsg) GetRespawnDelay() int32 {
+	if x != nil && x.RespawnDelay != nil {
+		return *x.RespawnDelay
+	}
+	return 0
+}
+
+func (x *APIRequest_CreateGameMsg) GetHalveScoreOnRespawn() bool {
+	if x != nil && x.HalveScoreOnRespawn != nil {
+		return *x.HalveScoreOnRespawn
+	}
+	return false
+}
+
+func (x *APIRequest_CreateGameMsg) GetShrinkInterval() int32 {
+	if x != nil && x.ShrinkInterval != nil {
+		return *x.ShrinkInterval
+	}
+	return 0
+}
+
+func (x *APIRequest_CreateGameMsg) GetTeamCount() int32 {
+	if x != nil && x.TeamCount != nil {
+		return *x.TeamCount
+	}
+	return 0
+}
+
+func (x *APIRequest_CreateGameMsg) GetSafeTeammates() bool {
+	if x != nil && x.SafeTeammates != nil {
+		return *x.SafeTeammates
+	}
+	return false
+}
+
+func (x *APIRequest_CreateGameMsg) GetSpawnDistance() int32 {
+	if x != nil && x.SpawnDistance != nil {
+		return *x.SpawnDistance
+	}
+	return Default_APIRequest_CreateGameMsg_SpawnDistance
+}
+
 type APIRequest_DiscoverGamesMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -681,6 +1316,7 @@ type APIRequest_JoinGameMsg struct {
 	PlayerName *string `protobuf:"bytes,2,req,name=player_name,json=playerName" json:"player_name,omitempty"`
 	GameName   *string `protobuf:"bytes,3,req,name=game_name,json=gameName" json:"game_name,omitempty"`
 	IsPlayer   *bool   `protobuf:"varint,4,req,name=is_player,json=isPlayer" json:"is_player,omitempty"`
+	Team       *int32  `protobuf:"varint,5,opt,name=team" json:"team,omitempty"`
 }
 
 func (x *APIRequest_JoinGameMsg) Reset() {
@@ -743,6 +1379,13 @@ func (x *APIRequest_JoinGameMsg) GetIsPlayer() bool {
 	return false
 }
 
+func (x *APIRequest_JoinGameMsg) GetTeam() int32 {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return 0
+}
+
 type APIRequest_SteerSnakeMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -939,17 +1582,18 @@ func (x *APIRequest_DisconnectMsg) GetToken() string {
 	return ""
 }
 
-type APIResponse_SuccessConnectMsg struct {
+type APIRequest_AddBotsMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token   *string `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
-	Timeout *int32  `protobuf:"varint,2,req,name=timeout" json:"timeout,omitempty"`
+	Token      *string        `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	Count      *int32         `protobuf:"varint,2,req,name=count" json:"count,omitempty"`
+	Difficulty *BotDifficulty `protobuf:"varint,3,req,name=difficulty,enum=api.BotDifficulty" json:"difficulty,omitempty"`
 }
 
-func (x *APIResponse_SuccessConnectMsg) Reset() {
-	*x = APIResponse_SuccessConnectMsg{}
+func (x *APIRequest_AddBotsMsg) Reset() {
+	*x = APIRequest_AddBotsMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -957,13 +1601,13 @@ func (x *APIResponse_SuccessConnectMsg) Reset() {
 	}
 }
 
-func (x *APIResponse_SuccessConnectMsg) String() string {
+func (x *APIRequest_AddBotsMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIResponse_SuccessConnectMsg) ProtoMessage() {}
+func (*APIRequest_AddBotsMsg) ProtoMessage() {}
 
-func (x *APIResponse_SuccessConnectMsg) ProtoReflect() protoreflect.Message {
+func (x *APIRequest_AddBotsMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -975,33 +1619,42 @@ func (x *APIResponse_SuccessConnectMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIResponse_SuccessConnectMsg.ProtoReflect.Descriptor instead.
-func (*APIResponse_SuccessConnectMsg) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use APIRequest_AddBotsMsg.ProtoReflect.Descriptor instead.
+func (*APIRequest_AddBotsMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0, 9}
 }
 
-func (x *APIResponse_SuccessConnectMsg) GetToken() string {
+func (x *APIRequest_AddBotsMsg) GetToken() string {
 	if x != nil && x.Token != nil {
 		return *x.Token
 	}
 	return ""
 }
 
-func (x *APIResponse_SuccessConnectMsg) GetTimeout() int32 {
-	if x != nil && x.Timeout != nil {
-		return *x.Timeout
+func (x *APIRequest_AddBotsMsg) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
 	}
 	return 0
 }
 
-type APIResponse_AckMsg struct {
+func (x *APIRequest_AddBotsMsg) GetDifficulty() BotDifficulty {
+	if x != nil && x.Difficulty != nil {
+		return *x.Difficulty
+	}
+	return BotDifficulty_EASY
+}
+
+type APIRequest_ListBotsMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Token *string `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
 }
 
-func (x *APIResponse_AckMsg) Reset() {
-	*x = APIResponse_AckMsg{}
+func (x *APIRequest_ListBotsMsg) Reset() {
+	*x = APIRequest_ListBotsMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1009,13 +1662,13 @@ func (x *APIResponse_AckMsg) Reset() {
 	}
 }
 
-func (x *APIResponse_AckMsg) String() string {
+func (x *APIRequest_ListBotsMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIResponse_AckMsg) ProtoMessage() {}
+func (*APIRequest_ListBotsMsg) ProtoMessage() {}
 
-func (x *APIResponse_AckMsg) ProtoReflect() protoreflect.Message {
+func (x *APIRequest_ListBotsMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1027,21 +1680,29 @@ func (x *APIResponse_AckMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIResponse_AckMsg.ProtoReflect.Descriptor instead.
-func (*APIResponse_AckMsg) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use APIRequest_ListBotsMsg.ProtoReflect.Descriptor instead.
+func (*APIRequest_ListBotsMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0, 10}
 }
 
-type APIResponse_ErrorMsg struct {
+func (x *APIRequest_ListBotsMsg) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+type APIRequest_RemoveBotMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrorMessage *string `protobuf:"bytes,1,req,name=error_message,json=errorMessage" json:"error_message,omitempty"`
+	Token    *string `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	PlayerId *int32  `protobuf:"varint,2,req,name=player_id,json=playerId" json:"player_id,omitempty"`
 }
 
-func (x *APIResponse_ErrorMsg) Reset() {
-	*x = APIResponse_ErrorMsg{}
+func (x *APIRequest_RemoveBotMsg) Reset() {
+	*x = APIRequest_RemoveBotMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1049,13 +1710,13 @@ func (x *APIResponse_ErrorMsg) Reset() {
 	}
 }
 
-func (x *APIResponse_ErrorMsg) String() string {
+func (x *APIRequest_RemoveBotMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIResponse_ErrorMsg) ProtoMessage() {}
+func (*APIRequest_RemoveBotMsg) ProtoMessage() {}
 
-func (x *APIResponse_ErrorMsg) ProtoReflect() protoreflect.Message {
+func (x *APIRequest_RemoveBotMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1067,28 +1728,35 @@ func (x *APIResponse_ErrorMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIResponse_ErrorMsg.ProtoReflect.Descriptor instead.
-func (*APIResponse_ErrorMsg) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 2}
+// Deprecated: Use APIRequest_RemoveBotMsg.ProtoReflect.Descriptor instead.
+func (*APIRequest_RemoveBotMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0, 11}
 }
 
-func (x *APIResponse_ErrorMsg) GetErrorMessage() string {
-	if x != nil && x.ErrorMessage != nil {
-		return *x.ErrorMessage
+func (x *APIRequest_RemoveBotMsg) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
 	}
 	return ""
 }
 
-type APIResponse_GameListMsg struct {
+func (x *APIRequest_RemoveBotMsg) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return 0
+}
+
+type APIRequest_ListMapsMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Games []*APIResponse_GameListMsg_GameInfo `protobuf:"bytes,1,rep,name=games" json:"games,omitempty"`
+	Token *string `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
 }
 
-func (x *APIResponse_GameListMsg) Reset() {
-	*x = APIResponse_GameListMsg{}
+func (x *APIRequest_ListMapsMsg) Reset() {
+	*x = APIRequest_ListMapsMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1096,13 +1764,13 @@ func (x *APIResponse_GameListMsg) Reset() {
 	}
 }
 
-func (x *APIResponse_GameListMsg) String() string {
+func (x *APIRequest_ListMapsMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIResponse_GameListMsg) ProtoMessage() {}
+func (*APIRequest_ListMapsMsg) ProtoMessage() {}
 
-func (x *APIResponse_GameListMsg) ProtoReflect() protoreflect.Message {
+func (x *APIRequest_ListMapsMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1114,30 +1782,28 @@ func (x *APIResponse_GameListMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIResponse_GameListMsg.ProtoReflect.Descriptor instead.
-func (*APIResponse_GameListMsg) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 3}
+// Deprecated: Use APIRequest_ListMapsMsg.ProtoReflect.Descriptor instead.
+func (*APIRequest_ListMapsMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0, 12}
 }
 
-func (x *APIResponse_GameListMsg) GetGames() []*APIResponse_GameListMsg_GameInfo {
-	if x != nil {
-		return x.Games
+func (x *APIRequest_ListMapsMsg) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
 	}
-	return nil
+	return ""
 }
 
-type APIResponse_GameStateMsg struct {
+type APIRequest_GetStatsMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Snakes  []*APIResponse_GameStateMsg_Snake  `protobuf:"bytes,2,rep,name=snakes" json:"snakes,omitempty"`
-	Foods   []*APIResponse_GameStateMsg_Coord  `protobuf:"bytes,3,rep,name=foods" json:"foods,omitempty"`
-	Players []*APIResponse_GameStateMsg_Player `protobuf:"bytes,4,rep,name=players" json:"players,omitempty"`
+	Token *string `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
 }
 
-func (x *APIResponse_GameStateMsg) Reset() {
-	*x = APIResponse_GameStateMsg{}
+func (x *APIRequest_GetStatsMsg) Reset() {
+	*x = APIRequest_GetStatsMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1145,13 +1811,13 @@ func (x *APIResponse_GameStateMsg) Reset() {
 	}
 }
 
-func (x *APIResponse_GameStateMsg) String() string {
+func (x *APIRequest_GetStatsMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIResponse_GameStateMsg) ProtoMessage() {}
+func (*APIRequest_GetStatsMsg) ProtoMessage() {}
 
-func (x *APIResponse_GameStateMsg) ProtoReflect() protoreflect.Message {
+func (x *APIRequest_GetStatsMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1163,45 +1829,34 @@ func (x *APIResponse_GameStateMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIResponse_GameStateMsg.ProtoReflect.Descriptor instead.
-func (*APIResponse_GameStateMsg) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 4}
-}
-
-func (x *APIResponse_GameStateMsg) GetSnakes() []*APIResponse_GameStateMsg_Snake {
-	if x != nil {
-		return x.Snakes
-	}
-	return nil
-}
-
-func (x *APIResponse_GameStateMsg) GetFoods() []*APIResponse_GameStateMsg_Coord {
-	if x != nil {
-		return x.Foods
-	}
-	return nil
+// Deprecated: Use APIRequest_GetStatsMsg.ProtoReflect.Descriptor instead.
+func (*APIRequest_GetStatsMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0, 13}
 }
 
-func (x *APIResponse_GameStateMsg) GetPlayers() []*APIResponse_GameStateMsg_Player {
-	if x != nil {
-		return x.Players
+func (x *APIRequest_GetStatsMsg) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
 	}
-	return nil
+	return ""
 }
 
-type APIResponse_GameListMsg_GameInfo struct {
+type APIRequest_RestartRoundMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GameName   *string `protobuf:"bytes,1,req,name=gameName" json:"gameName,omitempty"`
-	Width      *int32  `protobuf:"varint,2,req,name=width" json:"width,omitempty"`
-	Height     *int32  `protobuf:"varint,3,req,name=height" json:"height,omitempty"`
-	StateDelay *int32  `protobuf:"varint,4,req,name=stateDelay" json:"stateDelay,omitempty"`
+	Token      *string `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	KeepScores *bool   `protobuf:"varint,2,opt,name=keep_scores,json=keepScores,def=0" json:"keep_scores,omitempty"`
 }
 
-func (x *APIResponse_GameListMsg_GameInfo) Reset() {
-	*x = APIResponse_GameListMsg_GameInfo{}
+// Default values for APIRequest_RestartRoundMsg fields.
+const (
+	Default_APIRequest_RestartRoundMsg_KeepScores = bool(false)
+)
+
+func (x *APIRequest_RestartRoundMsg) Reset() {
+	*x = APIRequest_RestartRoundMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1209,13 +1864,13 @@ func (x *APIResponse_GameListMsg_GameInfo) Reset() {
 	}
 }
 
-func (x *APIResponse_GameListMsg_GameInfo) String() string {
+func (x *APIRequest_RestartRoundMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIResponse_GameListMsg_GameInfo) ProtoMessage() {}
+func (*APIRequest_RestartRoundMsg) ProtoMessage() {}
 
-func (x *APIResponse_GameListMsg_GameInfo) ProtoReflect() protoreflect.Message {
+func (x *APIRequest_RestartRoundMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1227,50 +1882,36 @@ func (x *APIResponse_GameListMsg_GameInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIResponse_GameListMsg_GameInfo.ProtoReflect.Descriptor instead.
-func (*APIResponse_GameListMsg_GameInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 3, 0}
+// Deprecated: Use APIRequest_RestartRoundMsg.ProtoReflect.Descriptor instead.
+func (*APIRequest_RestartRoundMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0, 14}
 }
 
-func (x *APIResponse_GameListMsg_GameInfo) GetGameName() string {
-	if x != nil && x.GameName != nil {
-		return *x.GameName
+func (x *APIRequest_RestartRoundMsg) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
 	}
 	return ""
 }
 
-func (x *APIResponse_GameListMsg_GameInfo) GetWidth() int32 {
-	if x != nil && x.Width != nil {
-		return *x.Width
-	}
-	return 0
-}
-
-func (x *APIResponse_GameListMsg_GameInfo) GetHeight() int32 {
-	if x != nil && x.Height != nil {
-		return *x.Height
-	}
-	return 0
-}
-
-func (x *APIResponse_GameListMsg_GameInfo) GetStateDelay() int32 {
-	if x != nil && x.StateDelay != nil {
-		return *x.StateDelay
+func (x *APIRequest_RestartRoundMsg) GetKeepScores() bool {
+	if x != nil && x.KeepScores != nil {
+		return *x.KeepScores
 	}
-	return 0
+	return Default_APIRequest_RestartRoundMsg_KeepScores
 }
 
-type APIResponse_GameStateMsg_Coord struct {
+type APIResponse_SuccessConnectMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	X *int32 `protobuf:"zigzag32,1,req,name=x" json:"x,omitempty"`
-	Y *int32 `protobuf:"zigzag32,2,req,name=y" json:"y,omitempty"`
+	Token   *string `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	Timeout *int32  `protobuf:"varint,2,req,name=timeout" json:"timeout,omitempty"`
 }
 
-func (x *APIResponse_GameStateMsg_Coord) Reset() {
-	*x = APIResponse_GameStateMsg_Coord{}
+func (x *APIResponse_SuccessConnectMsg) Reset() {
+	*x = APIResponse_SuccessConnectMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1278,13 +1919,13 @@ func (x *APIResponse_GameStateMsg_Coord) Reset() {
 	}
 }
 
-func (x *APIResponse_GameStateMsg_Coord) String() string {
+func (x *APIResponse_SuccessConnectMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIResponse_GameStateMsg_Coord) ProtoMessage() {}
+func (*APIResponse_SuccessConnectMsg) ProtoMessage() {}
 
-func (x *APIResponse_GameStateMsg_Coord) ProtoReflect() protoreflect.Message {
+func (x *APIResponse_SuccessConnectMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1296,9 +1937,646 @@ func (x *APIResponse_GameStateMsg_Coord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIResponse_GameStateMsg_Coord.ProtoReflect.Descriptor instead.
-func (*APIResponse_GameStateMsg_Coord) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 4, 0}
+// Deprecated: Use APIResponse_SuccessConnectMsg.ProtoReflect.Descriptor instead.
+func (*APIResponse_SuccessConnectMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *APIResponse_SuccessConnectMsg) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *APIResponse_SuccessConnectMsg) GetTimeout() int32 {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return 0
+}
+
+type APIResponse_AckMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *APIResponse_AckMsg) Reset() {
+	*x = APIResponse_AckMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_AckMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_AckMsg) ProtoMessage() {}
+
+func (x *APIResponse_AckMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_AckMsg.ProtoReflect.Descriptor instead.
+func (*APIResponse_AckMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type APIResponse_ErrorMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage *string `protobuf:"bytes,1,req,name=error_message,json=errorMessage" json:"error_message,omitempty"`
+}
+
+func (x *APIResponse_ErrorMsg) Reset() {
+	*x = APIResponse_ErrorMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_ErrorMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_ErrorMsg) ProtoMessage() {}
+
+func (x *APIResponse_ErrorMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_ErrorMsg.ProtoReflect.Descriptor instead.
+func (*APIResponse_ErrorMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *APIResponse_ErrorMsg) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+type APIResponse_GameListMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*APIResponse_GameListMsg_GameInfo `protobuf:"bytes,1,rep,name=games" json:"games,omitempty"`
+}
+
+func (x *APIResponse_GameListMsg) Reset() {
+	*x = APIResponse_GameListMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameListMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameListMsg) ProtoMessage() {}
+
+func (x *APIResponse_GameListMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameListMsg.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameListMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *APIResponse_GameListMsg) GetGames() []*APIResponse_GameListMsg_GameInfo {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type APIResponse_GameStateMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snakes          []*APIResponse_GameStateMsg_Snake   `protobuf:"bytes,2,rep,name=snakes" json:"snakes,omitempty"`
+	Foods           []*APIResponse_GameStateMsg_Coord   `protobuf:"bytes,3,rep,name=foods" json:"foods,omitempty"`
+	Players         []*APIResponse_GameStateMsg_Player  `protobuf:"bytes,4,rep,name=players" json:"players,omitempty"`
+	StateOrder      *int32                              `protobuf:"varint,5,opt,name=state_order,json=stateOrder" json:"state_order,omitempty"`
+	Events          []*APIResponse_GameStateMsg_Event   `protobuf:"bytes,6,rep,name=events" json:"events,omitempty"`
+	Walled          *bool                               `protobuf:"varint,7,opt,name=walled" json:"walled,omitempty"`
+	Walls           []*APIResponse_GameStateMsg_Coord   `protobuf:"bytes,8,rep,name=walls" json:"walls,omitempty"`
+	Result          *APIResponse_GameStateMsg_Result    `protobuf:"bytes,9,opt,name=result" json:"result,omitempty"`
+	Round           *int32                              `protobuf:"varint,10,opt,name=round" json:"round,omitempty"`
+	Items           []*APIResponse_GameStateMsg_Item    `protobuf:"bytes,11,rep,name=items" json:"items,omitempty"`
+	FoodKinds       []APIResponse_GameStateMsg_FoodKind `protobuf:"varint,12,rep,name=food_kinds,json=foodKinds,enum=api.APIResponse_GameStateMsg_FoodKind" json:"food_kinds,omitempty"`
+	ArenaLevel      *int32                              `protobuf:"varint,13,opt,name=arena_level,json=arenaLevel" json:"arena_level,omitempty"`
+	NextShrinkOrder *int32                              `protobuf:"varint,14,opt,name=next_shrink_order,json=nextShrinkOrder" json:"next_shrink_order,omitempty"`
+	Teams           []*APIResponse_GameStateMsg_Team    `protobuf:"bytes,15,rep,name=teams" json:"teams,omitempty"`
+}
+
+func (x *APIResponse_GameStateMsg) Reset() {
+	*x = APIResponse_GameStateMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameStateMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameStateMsg) ProtoMessage() {}
+
+func (x *APIResponse_GameStateMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameStateMsg.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameStateMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *APIResponse_GameStateMsg) GetSnakes() []*APIResponse_GameStateMsg_Snake {
+	if x != nil {
+		return x.Snakes
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg) GetFoods() []*APIResponse_GameStateMsg_Coord {
+	if x != nil {
+		return x.Foods
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg) GetPlayers() []*APIResponse_GameStateMsg_Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg) GetStateOrder() int32 {
+	if x != nil && x.StateOrder != nil {
+		return *x.StateOrder
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg) GetEvents() []*APIResponse_GameStateMsg_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg) GetWalled() bool {
+	if x != nil && x.Walled != nil {
+		return *x.Walled
+	}
+	return false
+}
+
+func (x *APIResponse_GameStateMsg) GetWalls() []*APIResponse_GameStateMsg_Coord {
+	if x != nil {
+		return x.Walls
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg) GetResult() *APIResponse_GameStateMsg_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg) GetRound() int32 {
+	if x != nil && x.Round != nil {
+		return *x.Round
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg) GetItems() []*APIResponse_GameStateMsg_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg) GetFoodKinds() []APIResponse_GameStateMsg_FoodKind {
+	if x != nil {
+		return x.FoodKinds
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg) GetArenaLevel() int32 {
+	if x != nil && x.ArenaLevel != nil {
+		return *x.ArenaLevel
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg) GetNextShrinkOrder() int32 {
+	if x != nil && x.NextShrinkOrder != nil {
+		return *x.NextShrinkOrder
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg) GetTeams() []*APIResponse_GameStateMsg_Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type APIResponse_BotListMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bots []*APIResponse_BotListMsg_Bot `protobuf:"bytes,1,rep,name=bots" json:"bots,omitempty"`
+}
+
+func (x *APIResponse_BotListMsg) Reset() {
+	*x = APIResponse_BotListMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_BotListMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_BotListMsg) ProtoMessage() {}
+
+func (x *APIResponse_BotListMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_BotListMsg.ProtoReflect.Descriptor instead.
+func (*APIResponse_BotListMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 5}
+}
+
+func (x *APIResponse_BotListMsg) GetBots() []*APIResponse_BotListMsg_Bot {
+	if x != nil {
+		return x.Bots
+	}
+	return nil
+}
+
+type APIResponse_StatsMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*APIResponse_StatsMsg_PlayerStats `protobuf:"bytes,1,rep,name=players" json:"players,omitempty"`
+}
+
+func (x *APIResponse_StatsMsg) Reset() {
+	*x = APIResponse_StatsMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_StatsMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_StatsMsg) ProtoMessage() {}
+
+func (x *APIResponse_StatsMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_StatsMsg.ProtoReflect.Descriptor instead.
+func (*APIResponse_StatsMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 6}
+}
+
+func (x *APIResponse_StatsMsg) GetPlayers() []*APIResponse_StatsMsg_PlayerStats {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type APIResponse_MapListMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Maps []*APIResponse_MapListMsg_MapInfo `protobuf:"bytes,1,rep,name=maps" json:"maps,omitempty"`
+}
+
+func (x *APIResponse_MapListMsg) Reset() {
+	*x = APIResponse_MapListMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_MapListMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_MapListMsg) ProtoMessage() {}
+
+func (x *APIResponse_MapListMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_MapListMsg.ProtoReflect.Descriptor instead.
+func (*APIResponse_MapListMsg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 7}
+}
+
+func (x *APIResponse_MapListMsg) GetMaps() []*APIResponse_MapListMsg_MapInfo {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+type APIResponse_GameListMsg_GameInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameName   *string                         `protobuf:"bytes,1,req,name=gameName" json:"gameName,omitempty"`
+	Width      *int32                          `protobuf:"varint,2,req,name=width" json:"width,omitempty"`
+	Height     *int32                          `protobuf:"varint,3,req,name=height" json:"height,omitempty"`
+	StateDelay *int32                          `protobuf:"varint,4,req,name=stateDelay" json:"stateDelay,omitempty"`
+	BotCount   *int32                          `protobuf:"varint,5,opt,name=botCount" json:"botCount,omitempty"`
+	Walled     *bool                           `protobuf:"varint,6,opt,name=walled" json:"walled,omitempty"`
+	Teams      []*APIResponse_GameListMsg_Team `protobuf:"bytes,7,rep,name=teams" json:"teams,omitempty"`
+	CanJoin    *bool                           `protobuf:"varint,8,opt,name=can_join,json=canJoin" json:"can_join,omitempty"`
+}
+
+func (x *APIResponse_GameListMsg_GameInfo) Reset() {
+	*x = APIResponse_GameListMsg_GameInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameListMsg_GameInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameListMsg_GameInfo) ProtoMessage() {}
+
+func (x *APIResponse_GameListMsg_GameInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameListMsg_GameInfo.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameListMsg_GameInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 3, 0}
+}
+
+func (x *APIResponse_GameListMsg_GameInfo) GetGameName() string {
+	if x != nil && x.GameName != nil {
+		return *x.GameName
+	}
+	return ""
+}
+
+func (x *APIResponse_GameListMsg_GameInfo) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *APIResponse_GameListMsg_GameInfo) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *APIResponse_GameListMsg_GameInfo) GetStateDelay() int32 {
+	if x != nil && x.StateDelay != nil {
+		return *x.StateDelay
+	}
+	return 0
+}
+
+func (x *APIResponse_GameListMsg_GameInfo) GetBotCount() int32 {
+	if x != nil && x.BotCount != nil {
+		return *x.BotCount
+	}
+	return 0
+}
+
+func (x *APIResponse_GameListMsg_GameInfo) GetWalled() bool {
+	if x != nil && x.Walled != nil {
+		return *x.Walled
+	}
+	return false
+}
+
+func (x *APIResponse_GameListMsg_GameInfo) GetTeams() []*APIResponse_GameListMsg_Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *APIResponse_GameListMsg_GameInfo) GetCanJoin() bool {
+	if x != nil && x.CanJoin != nil {
+		return *x.CanJoin
+	}
+	return false
+}
+
+type APIResponse_GameListMsg_Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          *int32   `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	PlayerNames []string `protobuf:"bytes,2,rep,name=player_names,json=playerNames" json:"player_names,omitempty"`
+}
+
+func (x *APIResponse_GameListMsg_Team) Reset() {
+	*x = APIResponse_GameListMsg_Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameListMsg_Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameListMsg_Team) ProtoMessage() {}
+
+func (x *APIResponse_GameListMsg_Team) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameListMsg_Team.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameListMsg_Team) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 3, 1}
+}
+
+func (x *APIResponse_GameListMsg_Team) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *APIResponse_GameListMsg_Team) GetPlayerNames() []string {
+	if x != nil {
+		return x.PlayerNames
+	}
+	return nil
+}
+
+type APIResponse_GameStateMsg_Coord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X *int32 `protobuf:"zigzag32,1,req,name=x" json:"x,omitempty"`
+	Y *int32 `protobuf:"zigzag32,2,req,name=y" json:"y,omitempty"`
+}
+
+func (x *APIResponse_GameStateMsg_Coord) Reset() {
+	*x = APIResponse_GameStateMsg_Coord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameStateMsg_Coord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameStateMsg_Coord) ProtoMessage() {}
+
+func (x *APIResponse_GameStateMsg_Coord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Coord.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameStateMsg_Coord) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 0}
 }
 
 func (x *APIResponse_GameStateMsg_Coord) GetX() int32 {
@@ -1308,40 +2586,458 @@ func (x *APIResponse_GameStateMsg_Coord) GetX() int32 {
 	return 0
 }
 
-func (x *APIResponse_GameStateMsg_Coord) GetY() int32 {
-	if x != nil && x.Y != nil {
-		return *x.Y
+func (x *APIResponse_GameStateMsg_Coord) GetY() int32 {
+	if x != nil && x.Y != nil {
+		return *x.Y
+	}
+	return 0
+}
+
+type APIResponse_GameStateMsg_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind  *APIResponse_GameStateMsg_Item_Kind `protobuf:"varint,1,req,name=kind,enum=api.APIResponse_GameStateMsg_Item_Kind" json:"kind,omitempty"`
+	Coord *APIResponse_GameStateMsg_Coord     `protobuf:"bytes,2,req,name=coord" json:"coord,omitempty"`
+}
+
+func (x *APIResponse_GameStateMsg_Item) Reset() {
+	*x = APIResponse_GameStateMsg_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameStateMsg_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameStateMsg_Item) ProtoMessage() {}
+
+func (x *APIResponse_GameStateMsg_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Item.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameStateMsg_Item) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 1}
+}
+
+func (x *APIResponse_GameStateMsg_Item) GetKind() APIResponse_GameStateMsg_Item_Kind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return APIResponse_GameStateMsg_Item_SPEED_BOOST
+}
+
+func (x *APIResponse_GameStateMsg_Item) GetCoord() *APIResponse_GameStateMsg_Coord {
+	if x != nil {
+		return x.Coord
+	}
+	return nil
+}
+
+type APIResponse_GameStateMsg_Effect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind           *APIResponse_GameStateMsg_Item_Kind `protobuf:"varint,1,req,name=kind,enum=api.APIResponse_GameStateMsg_Item_Kind" json:"kind,omitempty"`
+	RemainingTurns *int32                              `protobuf:"varint,2,req,name=remaining_turns,json=remainingTurns" json:"remaining_turns,omitempty"`
+}
+
+func (x *APIResponse_GameStateMsg_Effect) Reset() {
+	*x = APIResponse_GameStateMsg_Effect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameStateMsg_Effect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameStateMsg_Effect) ProtoMessage() {}
+
+func (x *APIResponse_GameStateMsg_Effect) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Effect.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameStateMsg_Effect) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 2}
+}
+
+func (x *APIResponse_GameStateMsg_Effect) GetKind() APIResponse_GameStateMsg_Item_Kind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return APIResponse_GameStateMsg_Item_SPEED_BOOST
+}
+
+func (x *APIResponse_GameStateMsg_Effect) GetRemainingTurns() int32 {
+	if x != nil && x.RemainingTurns != nil {
+		return *x.RemainingTurns
+	}
+	return 0
+}
+
+type APIResponse_GameStateMsg_Snake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId      *int32                             `protobuf:"varint,1,req,name=player_id,json=playerId" json:"player_id,omitempty"`
+	Points        []*APIResponse_GameStateMsg_Coord  `protobuf:"bytes,2,rep,name=points" json:"points,omitempty"`
+	HeadDirection *Direction                         `protobuf:"varint,3,req,name=head_direction,json=headDirection,enum=api.Direction" json:"head_direction,omitempty"`
+	Effects       []*APIResponse_GameStateMsg_Effect `protobuf:"bytes,4,rep,name=effects" json:"effects,omitempty"`
+}
+
+func (x *APIResponse_GameStateMsg_Snake) Reset() {
+	*x = APIResponse_GameStateMsg_Snake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameStateMsg_Snake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameStateMsg_Snake) ProtoMessage() {}
+
+func (x *APIResponse_GameStateMsg_Snake) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Snake.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameStateMsg_Snake) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 3}
+}
+
+func (x *APIResponse_GameStateMsg_Snake) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg_Snake) GetPoints() []*APIResponse_GameStateMsg_Coord {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg_Snake) GetHeadDirection() Direction {
+	if x != nil && x.HeadDirection != nil {
+		return *x.HeadDirection
+	}
+	return Direction_UP
+}
+
+func (x *APIResponse_GameStateMsg_Snake) GetEffects() []*APIResponse_GameStateMsg_Effect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+type APIResponse_GameStateMsg_Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  *string                        `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Id    *int32                         `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
+	Score *int32                         `protobuf:"varint,3,req,name=score" json:"score,omitempty"`
+	Role  *APIResponse_GameStateMsg_Role `protobuf:"varint,4,req,name=role,enum=api.APIResponse_GameStateMsg_Role" json:"role,omitempty"`
+	Team  *int32                         `protobuf:"varint,5,opt,name=team" json:"team,omitempty"`
+}
+
+func (x *APIResponse_GameStateMsg_Player) Reset() {
+	*x = APIResponse_GameStateMsg_Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameStateMsg_Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameStateMsg_Player) ProtoMessage() {}
+
+func (x *APIResponse_GameStateMsg_Player) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Player.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameStateMsg_Player) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 4}
+}
+
+func (x *APIResponse_GameStateMsg_Player) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *APIResponse_GameStateMsg_Player) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg_Player) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg_Player) GetRole() APIResponse_GameStateMsg_Role {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return APIResponse_GameStateMsg_NORMAL
+}
+
+func (x *APIResponse_GameStateMsg_Player) GetTeam() int32 {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return 0
+}
+
+type APIResponse_GameStateMsg_Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *int32  `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	Score     *int32  `protobuf:"varint,2,req,name=score" json:"score,omitempty"`
+	PlayerIds []int32 `protobuf:"varint,3,rep,name=player_ids,json=playerIds" json:"player_ids,omitempty"`
+}
+
+func (x *APIResponse_GameStateMsg_Team) Reset() {
+	*x = APIResponse_GameStateMsg_Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameStateMsg_Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameStateMsg_Team) ProtoMessage() {}
+
+func (x *APIResponse_GameStateMsg_Team) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Team.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameStateMsg_Team) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 5}
+}
+
+func (x *APIResponse_GameStateMsg_Team) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg_Team) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg_Team) GetPlayerIds() []int32 {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+type APIResponse_GameStateMsg_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      *APIResponse_GameStateMsg_Event_Type       `protobuf:"varint,1,req,name=type,enum=api.APIResponse_GameStateMsg_Event_Type" json:"type,omitempty"`
+	PlayerId  *int32                                     `protobuf:"varint,2,opt,name=player_id,json=playerId" json:"player_id,omitempty"`
+	Coord     *APIResponse_GameStateMsg_Coord            `protobuf:"bytes,3,opt,name=coord" json:"coord,omitempty"`
+	Cause     *APIResponse_GameStateMsg_Event_DeathCause `protobuf:"varint,4,opt,name=cause,enum=api.APIResponse_GameStateMsg_Event_DeathCause" json:"cause,omitempty"`
+	KillerIds []int32                                    `protobuf:"varint,5,rep,name=killer_ids,json=killerIds" json:"killer_ids,omitempty"`
+	Points    *int32                                     `protobuf:"varint,6,opt,name=points" json:"points,omitempty"`
+	Item      *APIResponse_GameStateMsg_Item_Kind        `protobuf:"varint,7,opt,name=item,enum=api.APIResponse_GameStateMsg_Item_Kind" json:"item,omitempty"`
+}
+
+func (x *APIResponse_GameStateMsg_Event) Reset() {
+	*x = APIResponse_GameStateMsg_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameStateMsg_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameStateMsg_Event) ProtoMessage() {}
+
+func (x *APIResponse_GameStateMsg_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Event.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameStateMsg_Event) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 6}
+}
+
+func (x *APIResponse_GameStateMsg_Event) GetType() APIResponse_GameStateMsg_Event_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return APIResponse_GameStateMsg_Event_FOOD_EATEN
+}
+
+func (x *APIResponse_GameStateMsg_Event) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg_Event) GetCoord() *APIResponse_GameStateMsg_Coord {
+	if x != nil {
+		return x.Coord
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg_Event) GetCause() APIResponse_GameStateMsg_Event_DeathCause {
+	if x != nil && x.Cause != nil {
+		return *x.Cause
+	}
+	return APIResponse_GameStateMsg_Event_SELF
+}
+
+func (x *APIResponse_GameStateMsg_Event) GetKillerIds() []int32 {
+	if x != nil {
+		return x.KillerIds
+	}
+	return nil
+}
+
+func (x *APIResponse_GameStateMsg_Event) GetPoints() int32 {
+	if x != nil && x.Points != nil {
+		return *x.Points
 	}
 	return 0
 }
 
-type APIResponse_GameStateMsg_Snake struct {
+func (x *APIResponse_GameStateMsg_Event) GetItem() APIResponse_GameStateMsg_Item_Kind {
+	if x != nil && x.Item != nil {
+		return *x.Item
+	}
+	return APIResponse_GameStateMsg_Item_SPEED_BOOST
+}
+
+type APIResponse_GameStateMsg_Result struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerId      *int32                            `protobuf:"varint,1,req,name=player_id,json=playerId" json:"player_id,omitempty"`
-	Points        []*APIResponse_GameStateMsg_Coord `protobuf:"bytes,2,rep,name=points" json:"points,omitempty"`
-	HeadDirection *Direction                        `protobuf:"varint,3,req,name=head_direction,json=headDirection,enum=api.Direction" json:"head_direction,omitempty"`
+	Reason    *APIResponse_GameStateMsg_Result_FinishReason `protobuf:"varint,1,req,name=reason,enum=api.APIResponse_GameStateMsg_Result_FinishReason" json:"reason,omitempty"`
+	WinnerId  *int32                                        `protobuf:"varint,2,opt,name=winner_id,json=winnerId" json:"winner_id,omitempty"`
+	Standings []*APIResponse_GameStateMsg_Result_Standing   `protobuf:"bytes,3,rep,name=standings" json:"standings,omitempty"`
 }
 
-func (x *APIResponse_GameStateMsg_Snake) Reset() {
-	*x = APIResponse_GameStateMsg_Snake{}
+func (x *APIResponse_GameStateMsg_Result) Reset() {
+	*x = APIResponse_GameStateMsg_Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[18]
+		mi := &file_api_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *APIResponse_GameStateMsg_Snake) String() string {
+func (x *APIResponse_GameStateMsg_Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIResponse_GameStateMsg_Snake) ProtoMessage() {}
+func (*APIResponse_GameStateMsg_Result) ProtoMessage() {}
 
-func (x *APIResponse_GameStateMsg_Snake) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[18]
+func (x *APIResponse_GameStateMsg_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1352,60 +3048,384 @@ func (x *APIResponse_GameStateMsg_Snake) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIResponse_GameStateMsg_Snake.ProtoReflect.Descriptor instead.
-func (*APIResponse_GameStateMsg_Snake) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 4, 1}
+// Deprecated: Use APIResponse_GameStateMsg_Result.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameStateMsg_Result) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 7}
 }
 
-func (x *APIResponse_GameStateMsg_Snake) GetPlayerId() int32 {
+func (x *APIResponse_GameStateMsg_Result) GetReason() APIResponse_GameStateMsg_Result_FinishReason {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return APIResponse_GameStateMsg_Result_TURN_LIMIT
+}
+
+func (x *APIResponse_GameStateMsg_Result) GetWinnerId() int32 {
+	if x != nil && x.WinnerId != nil {
+		return *x.WinnerId
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg_Result) GetStandings() []*APIResponse_GameStateMsg_Result_Standing {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type APIResponse_GameStateMsg_Result_Standing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Place    *int32  `protobuf:"varint,1,req,name=place" json:"place,omitempty"`
+	PlayerId *int32  `protobuf:"varint,2,req,name=player_id,json=playerId" json:"player_id,omitempty"`
+	Name     *string `protobuf:"bytes,3,req,name=name" json:"name,omitempty"`
+	Score    *int32  `protobuf:"varint,4,req,name=score" json:"score,omitempty"`
+}
+
+func (x *APIResponse_GameStateMsg_Result_Standing) Reset() {
+	*x = APIResponse_GameStateMsg_Result_Standing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_GameStateMsg_Result_Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_GameStateMsg_Result_Standing) ProtoMessage() {}
+
+func (x *APIResponse_GameStateMsg_Result_Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_GameStateMsg_Result_Standing.ProtoReflect.Descriptor instead.
+func (*APIResponse_GameStateMsg_Result_Standing) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 4, 7, 0}
+}
+
+func (x *APIResponse_GameStateMsg_Result_Standing) GetPlace() int32 {
+	if x != nil && x.Place != nil {
+		return *x.Place
+	}
+	return 0
+}
+
+func (x *APIResponse_GameStateMsg_Result_Standing) GetPlayerId() int32 {
 	if x != nil && x.PlayerId != nil {
 		return *x.PlayerId
 	}
 	return 0
 }
 
-func (x *APIResponse_GameStateMsg_Snake) GetPoints() []*APIResponse_GameStateMsg_Coord {
+func (x *APIResponse_GameStateMsg_Result_Standing) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *APIResponse_GameStateMsg_Result_Standing) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+type APIResponse_BotListMsg_Bot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId   *int32         `protobuf:"varint,1,req,name=player_id,json=playerId" json:"player_id,omitempty"`
+	Name       *string        `protobuf:"bytes,2,req,name=name" json:"name,omitempty"`
+	Score      *int32         `protobuf:"varint,3,req,name=score" json:"score,omitempty"`
+	Difficulty *BotDifficulty `protobuf:"varint,4,req,name=difficulty,enum=api.BotDifficulty" json:"difficulty,omitempty"`
+	IsAlive    *bool          `protobuf:"varint,5,req,name=is_alive,json=isAlive" json:"is_alive,omitempty"`
+}
+
+func (x *APIResponse_BotListMsg_Bot) Reset() {
+	*x = APIResponse_BotListMsg_Bot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_BotListMsg_Bot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_BotListMsg_Bot) ProtoMessage() {}
+
+func (x *APIResponse_BotListMsg_Bot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_BotListMsg_Bot.ProtoReflect.Descriptor instead.
+func (*APIResponse_BotListMsg_Bot) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 5, 0}
+}
+
+func (x *APIResponse_BotListMsg_Bot) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return 0
+}
+
+func (x *APIResponse_BotListMsg_Bot) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *APIResponse_BotListMsg_Bot) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *APIResponse_BotListMsg_Bot) GetDifficulty() BotDifficulty {
+	if x != nil && x.Difficulty != nil {
+		return *x.Difficulty
+	}
+	return BotDifficulty_EASY
+}
+
+func (x *APIResponse_BotListMsg_Bot) GetIsAlive() bool {
+	if x != nil && x.IsAlive != nil {
+		return *x.IsAlive
+	}
+	return false
+}
+
+type APIResponse_StatsMsg_DeathCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cause *APIResponse_GameStateMsg_Event_DeathCause `protobuf:"varint,1,req,name=cause,enum=api.APIResponse_GameStateMsg_Event_DeathCause" json:"cause,omitempty"`
+	Count *int32                                     `protobuf:"varint,2,req,name=count" json:"count,omitempty"`
+}
+
+func (x *APIResponse_StatsMsg_DeathCount) Reset() {
+	*x = APIResponse_StatsMsg_DeathCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_StatsMsg_DeathCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_StatsMsg_DeathCount) ProtoMessage() {}
+
+func (x *APIResponse_StatsMsg_DeathCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_StatsMsg_DeathCount.ProtoReflect.Descriptor instead.
+func (*APIResponse_StatsMsg_DeathCount) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 6, 0}
+}
+
+func (x *APIResponse_StatsMsg_DeathCount) GetCause() APIResponse_GameStateMsg_Event_DeathCause {
+	if x != nil && x.Cause != nil {
+		return *x.Cause
+	}
+	return APIResponse_GameStateMsg_Event_SELF
+}
+
+func (x *APIResponse_StatsMsg_DeathCount) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type APIResponse_StatsMsg_PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId      *int32                             `protobuf:"varint,1,req,name=player_id,json=playerId" json:"player_id,omitempty"`
+	Name          *string                            `protobuf:"bytes,2,req,name=name" json:"name,omitempty"`
+	Score         *int32                             `protobuf:"varint,3,req,name=score" json:"score,omitempty"`
+	FoodEaten     *int32                             `protobuf:"varint,4,req,name=food_eaten,json=foodEaten" json:"food_eaten,omitempty"`
+	Kills         *int32                             `protobuf:"varint,5,req,name=kills" json:"kills,omitempty"`
+	Deaths        *int32                             `protobuf:"varint,6,req,name=deaths" json:"deaths,omitempty"`
+	DeathCauses   []*APIResponse_StatsMsg_DeathCount `protobuf:"bytes,7,rep,name=death_causes,json=deathCauses" json:"death_causes,omitempty"`
+	MaxLength     *int32                             `protobuf:"varint,8,req,name=max_length,json=maxLength" json:"max_length,omitempty"`
+	TicksSurvived *int32                             `protobuf:"varint,9,req,name=ticks_survived,json=ticksSurvived" json:"ticks_survived,omitempty"`
+	Turns         *int32                             `protobuf:"varint,10,req,name=turns" json:"turns,omitempty"`
+}
+
+func (x *APIResponse_StatsMsg_PlayerStats) Reset() {
+	*x = APIResponse_StatsMsg_PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse_StatsMsg_PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse_StatsMsg_PlayerStats) ProtoMessage() {}
+
+func (x *APIResponse_StatsMsg_PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse_StatsMsg_PlayerStats.ProtoReflect.Descriptor instead.
+func (*APIResponse_StatsMsg_PlayerStats) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 6, 1}
+}
+
+func (x *APIResponse_StatsMsg_PlayerStats) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return 0
+}
+
+func (x *APIResponse_StatsMsg_PlayerStats) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *APIResponse_StatsMsg_PlayerStats) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *APIResponse_StatsMsg_PlayerStats) GetFoodEaten() int32 {
+	if x != nil && x.FoodEaten != nil {
+		return *x.FoodEaten
+	}
+	return 0
+}
+
+func (x *APIResponse_StatsMsg_PlayerStats) GetKills() int32 {
+	if x != nil && x.Kills != nil {
+		return *x.Kills
+	}
+	return 0
+}
+
+func (x *APIResponse_StatsMsg_PlayerStats) GetDeaths() int32 {
+	if x != nil && x.Deaths != nil {
+		return *x.Deaths
+	}
+	return 0
+}
+
+func (x *APIResponse_StatsMsg_PlayerStats) GetDeathCauses() []*APIResponse_StatsMsg_DeathCount {
 	if x != nil {
-		return x.Points
+		return x.DeathCauses
 	}
 	return nil
 }
 
-func (x *APIResponse_GameStateMsg_Snake) GetHeadDirection() Direction {
-	if x != nil && x.HeadDirection != nil {
-		return *x.HeadDirection
+func (x *APIResponse_StatsMsg_PlayerStats) GetMaxLength() int32 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
 	}
-	return Direction_UP
+	return 0
 }
 
-type APIResponse_GameStateMsg_Player struct {
+func (x *APIResponse_StatsMsg_PlayerStats) GetTicksSurvived() int32 {
+	if x != nil && x.TicksSurvived != nil {
+		return *x.TicksSurvived
+	}
+	return 0
+}
+
+func (x *APIResponse_StatsMsg_PlayerStats) GetTurns() int32 {
+	if x != nil && x.Turns != nil {
+		return *x.Turns
+	}
+	return 0
+}
+
+type APIResponse_MapListMsg_MapInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  *string                        `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Id    *int32                         `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
-	Score *int32                         `protobuf:"varint,3,req,name=score" json:"score,omitempty"`
-	Role  *APIResponse_GameStateMsg_Role `protobuf:"varint,4,req,name=role,enum=api.APIResponse_GameStateMsg_Role" json:"role,omitempty"`
+	Name   *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Width  *int32  `protobuf:"varint,2,req,name=width" json:"width,omitempty"`
+	Height *int32  `protobuf:"varint,3,req,name=height" json:"height,omitempty"`
+	Walled *bool   `protobuf:"varint,4,req,name=walled" json:"walled,omitempty"`
 }
 
-func (x *APIResponse_GameStateMsg_Player) Reset() {
-	*x = APIResponse_GameStateMsg_Player{}
+func (x *APIResponse_MapListMsg_MapInfo) Reset() {
+	*x = APIResponse_MapListMsg_MapInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[19]
+		mi := &file_api_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *APIResponse_GameStateMsg_Player) String() string {
+func (x *APIResponse_MapListMsg_MapInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIResponse_GameStateMsg_Player) ProtoMessage() {}
+func (*APIResponse_MapListMsg_MapInfo) ProtoMessage() {}
 
-func (x *APIResponse_GameStateMsg_Player) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[19]
+func (x *APIResponse_MapListMsg_MapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,44 +3436,44 @@ func (x *APIResponse_GameStateMsg_Player) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIResponse_GameStateMsg_Player.ProtoReflect.Descriptor instead.
-func (*APIResponse_GameStateMsg_Player) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 4, 2}
+// Deprecated: Use APIResponse_MapListMsg_MapInfo.ProtoReflect.Descriptor instead.
+func (*APIResponse_MapListMsg_MapInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 7, 0}
 }
 
-func (x *APIResponse_GameStateMsg_Player) GetName() string {
+func (x *APIResponse_MapListMsg_MapInfo) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-func (x *APIResponse_GameStateMsg_Player) GetId() int32 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *APIResponse_MapListMsg_MapInfo) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
 	}
 	return 0
 }
 
-func (x *APIResponse_GameStateMsg_Player) GetScore() int32 {
-	if x != nil && x.Score != nil {
-		return *x.Score
+func (x *APIResponse_MapListMsg_MapInfo) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
 	}
 	return 0
 }
 
-func (x *APIResponse_GameStateMsg_Player) GetRole() APIResponse_GameStateMsg_Role {
-	if x != nil && x.Role != nil {
-		return *x.Role
+func (x *APIResponse_MapListMsg_MapInfo) GetWalled() bool {
+	if x != nil && x.Walled != nil {
+		return *x.Walled
 	}
-	return APIResponse_GameStateMsg_NORMAL
+	return false
 }
 
 var File_api_proto protoreflect.FileDescriptor
 
 var file_api_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69,
-	0x22, 0xca, 0x09, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0xbf, 0x14, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x07,
@@ -1489,26 +3509,89 @@ var file_api_proto_rawDesc = []byte{
 	0x6e, 0x65, 0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x63,
 	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x73,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x1a, 0x0c, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x1f, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0xd8, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
-	0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x02, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x02,
-	0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x02,
-	0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f,
-	0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x06, 0x20, 0x02, 0x28, 0x05, 0x52,
-	0x0a, 0x66, 0x6f, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20,
-	0x02, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d,
-	0x73, 0x1a, 0x28, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x47, 0x61, 0x6d,
-	0x65, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x7e, 0x0a, 0x0b, 0x4a,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x62,
+	0x6f, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f,
+	0x74, 0x73, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x42, 0x6f, 0x74, 0x73,
+	0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x74, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x74, 0x73, 0x4d, 0x73, 0x67,
+	0x48, 0x00, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0a,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x62, 0x6f, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x74, 0x4d, 0x73, 0x67, 0x48, 0x00,
+	0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x73, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x08, 0x6c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x73, 0x12, 0x46, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x48,
+	0x00, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x3a, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4d, 0x73, 0x67, 0x48,
+	0x00, 0x52, 0x08, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x1a, 0x0c, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x1f, 0x0a, 0x07, 0x50, 0x69, 0x6e,
+	0x67, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0xd3, 0x06, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x02, 0x28, 0x05, 0x52,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x02, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x6f, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x06, 0x20,
+	0x02, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12,
+	0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d,
+	0x73, 0x18, 0x07, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x4d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a,
+	0x05, 0x77, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x52, 0x05, 0x77, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x54, 0x75, 0x72, 0x6e, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x6e, 0x61, 0x6b,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x2f, 0x0a, 0x12, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x6f, 0x64, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x31, 0x52,
+	0x10, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x46, 0x6f, 0x6f, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x6f, 0x6c, 0x64, 0x65, 0x6e, 0x5f, 0x66, 0x6f, 0x6f, 0x64,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x67,
+	0x6f, 0x6c, 0x64, 0x65, 0x6e, 0x46, 0x6f, 0x6f, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x69, 0x73, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x6f, 0x64, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x6f, 0x69,
+	0x73, 0x6f, 0x6e, 0x46, 0x6f, 0x6f, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x33, 0x0a, 0x16, 0x68, 0x61, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x68, 0x61, 0x6c, 0x76, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x72, 0x69, 0x6e,
+	0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x73, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x6d, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01,
+	0x34, 0x52, 0x0d, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x1a, 0x28, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x92, 0x01, 0x0a, 0x0b, 0x4a,
 	0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
@@ -1516,60 +3599,107 @@ var file_api_proto_rawDesc = []byte{
 	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
 	0x20, 0x02, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
 	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20, 0x02, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x1a, 0x53, 0x0a, 0x0d, 0x53,
-	0x74, 0x65, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x1a,
+	0x53, 0x0a, 0x0d, 0x53, 0x74, 0x65, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x4d, 0x73, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x27, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x23, 0x0a,
+	0x0b, 0x45, 0x78, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x27, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x65, 0x6e, 0x1a, 0x25, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
 	0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x23, 0x0a, 0x0b, 0x45, 0x78, 0x69,
-	0x74, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x25,
-	0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0xc0, 0x09,
-	0x0a, 0x0b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
-	0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x61,
-	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x6b, 0x4d, 0x73,
-	0x67, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50,
-	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d,
-	0x73, 0x67, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x09, 0x67,
-	0x61, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x08,
-	0x67, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x09, 0x67,
-	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x43, 0x0a, 0x11, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02,
-	0x20, 0x02, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x08, 0x0a,
-	0x06, 0x41, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x0a, 0x08, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x4d, 0x73, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0xc0, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x6d,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x3b, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50,
-	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x73, 0x67, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
-	0x67, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x74, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69,
-	0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
-	0x02, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x02, 0x28, 0x05, 0x52,
-	0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x1a, 0xba, 0x04, 0x0a, 0x0c,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x6c, 0x0a, 0x0a, 0x41, 0x64, 0x64,
+	0x42, 0x6f, 0x74, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f,
+	0x74, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66,
+	0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x1a, 0x23, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x6f, 0x74, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x41, 0x0a, 0x0c,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x1a,
+	0x23, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x23, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x4f, 0x0a, 0x0f, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0a,
+	0x6b, 0x65, 0x65, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x8f, 0x23, 0x0a, 0x0b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x48, 0x00,
+	0x52, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x12, 0x2b, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x41, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x12, 0x31, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x3b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73,
+	0x67, 0x48, 0x00, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a,
+	0x0a, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67,
+	0x48, 0x00, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a,
+	0x08, 0x62, 0x6f, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x42, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x07,
+	0x62, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x1a, 0x43, 0x0a, 0x11, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x08, 0x0a, 0x06, 0x41, 0x63, 0x6b,
+	0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x0a, 0x08, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x84, 0x03, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x73, 0x67, 0x12, 0x3b, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73,
+	0x67, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x1a, 0xfc, 0x01, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a,
+	0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x02, 0x28, 0x05,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x6f, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f, 0x6a, 0x6f, 0x69,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x1a, 0x39, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x8e, 0x14, 0x0a, 0x0c,
 	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x3b, 0x0a, 0x06,
 	0x73, 0x6e, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
@@ -1582,34 +3712,219 @@ var file_api_proto_rawDesc = []byte{
 	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
 	0x65, 0x4d, 0x73, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x1a, 0x23, 0x0a, 0x05, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x12, 0x0c, 0x0a,
-	0x01, 0x78, 0x18, 0x01, 0x20, 0x02, 0x28, 0x11, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
-	0x18, 0x02, 0x20, 0x02, 0x28, 0x11, 0x52, 0x01, 0x79, 0x1a, 0x98, 0x01, 0x0a, 0x05, 0x53, 0x6e,
-	0x61, 0x6b, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x3b, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e,
-	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a,
-	0x0e, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x7a, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28,
-	0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
-	0x18, 0x04, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x4d, 0x73, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x77, 0x61,
+	0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52, 0x05,
+	0x77, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x4d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x66, 0x6f, 0x6f, 0x64, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50,
+	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x46, 0x6f, 0x6f, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x09, 0x66, 0x6f, 0x6f, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72,
+	0x65, 0x6e, 0x61, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x73, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x68, 0x72, 0x69,
+	0x6e, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d,
+	0x73, 0x1a, 0x23, 0x0a, 0x05, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x11, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20,
+	0x02, 0x28, 0x11, 0x52, 0x01, 0x79, 0x1a, 0xc4, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x3b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x05,
+	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64,
+	0x52, 0x05, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x22, 0x44, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x0f, 0x0a, 0x0b, 0x53, 0x50, 0x45, 0x45, 0x44, 0x5f, 0x42, 0x4f, 0x4f, 0x53, 0x54, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x52, 0x49, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x47, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x43, 0x4f, 0x52, 0x45,
+	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x10, 0x03, 0x1a, 0x6e, 0x0a,
+	0x06, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x4d, 0x73, 0x67, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0e, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x73, 0x1a, 0xd8, 0x01,
+	0x0a, 0x05, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x35, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x07, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x8e, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x02, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x36, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x1a, 0x4b, 0x0a, 0x04, 0x54, 0x65, 0x61,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x1a, 0xc2, 0x04, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x3c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x28,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x63,
+	0x6f, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52,
+	0x05, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x12, 0x44, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x4d, 0x73, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x61, 0x74, 0x68,
+	0x43, 0x61, 0x75, 0x73, 0x65, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x09, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0xae, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x4f,
+	0x44, 0x5f, 0x45, 0x41, 0x54, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x4e, 0x41,
+	0x4b, 0x45, 0x5f, 0x44, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49,
+	0x4e, 0x54, 0x53, 0x5f, 0x41, 0x57, 0x41, 0x52, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a,
+	0x0c, 0x46, 0x4f, 0x4f, 0x44, 0x5f, 0x53, 0x50, 0x41, 0x57, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x12, 0x0a, 0x0e, 0x5a, 0x4f, 0x4d, 0x42, 0x49, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x49,
+	0x53, 0x48, 0x45, 0x44, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53,
+	0x50, 0x41, 0x57, 0x4e, 0x45, 0x44, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x54, 0x45, 0x4d,
+	0x5f, 0x50, 0x49, 0x43, 0x4b, 0x45, 0x44, 0x5f, 0x55, 0x50, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x4e, 0x41, 0x4b, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x41, 0x57, 0x4e, 0x45, 0x44, 0x10,
+	0x08, 0x22, 0x38, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x74, 0x68, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12,
+	0x08, 0x0a, 0x04, 0x53, 0x45, 0x4c, 0x46, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x4e, 0x41,
+	0x4b, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x10,
+	0x02, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x1a, 0xe7, 0x02, 0x0a, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x49, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
-	0x22, 0x36, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d,
-	0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01,
-	0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x50, 0x55, 0x54, 0x59, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
-	0x56, 0x49, 0x45, 0x57, 0x45, 0x52, 0x10, 0x03, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
-	0x2a, 0x32, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a,
-	0x02, 0x55, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12,
-	0x08, 0x0a, 0x04, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x49, 0x47,
-	0x48, 0x54, 0x10, 0x04, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x61, 0x70, 0x69,
+	0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4b,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x67, 0x0a, 0x08, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x22, 0x3f, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f, 0x4c, 0x49,
+	0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x53, 0x4e,
+	0x41, 0x4b, 0x45, 0x10, 0x02, 0x22, 0x36, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a,
+	0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x53,
+	0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x50, 0x55, 0x54, 0x59, 0x10,
+	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x49, 0x45, 0x57, 0x45, 0x52, 0x10, 0x03, 0x22, 0x3d, 0x0a,
+	0x08, 0x46, 0x6f, 0x6f, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x52,
+	0x4d, 0x41, 0x4c, 0x5f, 0x46, 0x4f, 0x4f, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x4f,
+	0x4c, 0x44, 0x45, 0x4e, 0x5f, 0x46, 0x4f, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
+	0x4f, 0x49, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x4f, 0x44, 0x10, 0x02, 0x1a, 0xdf, 0x01, 0x0a,
+	0x0a, 0x42, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x33, 0x0a, 0x04, 0x62,
+	0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x6f, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x2e, 0x42, 0x6f, 0x74, 0x52, 0x04, 0x62, 0x6f, 0x74, 0x73,
+	0x1a, 0x9b, 0x01, 0x0a, 0x03, 0x42, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x32, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x02, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x74, 0x44, 0x69, 0x66,
+	0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18,
+	0x05, 0x20, 0x02, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x1a, 0xfe,
+	0x03, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x3f, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x4d, 0x73, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x1a, 0x68, 0x0a, 0x0a,
+	0x44, 0x65, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x05, 0x63, 0x61,
+	0x75, 0x73, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44,
+	0x65, 0x61, 0x74, 0x68, 0x43, 0x61, 0x75, 0x73, 0x65, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xc6, 0x02, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x6f, 0x6f, 0x64, 0x5f, 0x65, 0x61, 0x74, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x02, 0x28,
+	0x05, 0x52, 0x09, 0x66, 0x6f, 0x6f, 0x64, 0x45, 0x61, 0x74, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x69, 0x6c,
+	0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x18, 0x06, 0x20, 0x02,
+	0x28, 0x05, 0x52, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x64, 0x65,
+	0x61, 0x74, 0x68, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x61, 0x74,
+	0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x74, 0x68, 0x43, 0x61, 0x75,
+	0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x08, 0x20, 0x02, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x76,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x69, 0x63, 0x6b,
+	0x73, 0x53, 0x75, 0x72, 0x76, 0x69, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x18, 0x0a, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x1a,
+	0xaa, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x37,
+	0x0a, 0x04, 0x6d, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d,
+	0x61, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x6d, 0x61, 0x70, 0x73, 0x1a, 0x63, 0x0a, 0x07, 0x4d, 0x61, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x02, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x02, 0x28, 0x08, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x06, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x2a, 0x32, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x03, 0x12, 0x09, 0x0a,
+	0x05, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x04, 0x2a, 0x23, 0x0a, 0x0d, 0x42, 0x6f, 0x74, 0x44,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x41, 0x53,
+	0x59, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x41, 0x52, 0x44, 0x10, 0x02, 0x42, 0x07, 0x5a,
+	0x05, 0x2e, 0x2f, 0x61, 0x70, 0x69,
 }
 
 var (
@@ -1624,60 +3939,120 @@ func file_api_proto_rawDescGZIP() []byte {
 	return file_api_proto_rawDescData
 }
 
-var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_api_proto_goTypes = []interface{}{
-	(Direction)(0),                           // 0: api.Direction
-	(APIResponse_GameStateMsg_Role)(0),       // 1: api.APIResponse.GameStateMsg.Role
-	(*APIRequest)(nil),                       // 2: api.APIRequest
-	(*APIResponse)(nil),                      // 3: api.APIResponse
-	(*APIRequest_ConnectMsg)(nil),            // 4: api.APIRequest.ConnectMsg
-	(*APIRequest_PingMsg)(nil),               // 5: api.APIRequest.PingMsg
-	(*APIRequest_CreateGameMsg)(nil),         // 6: api.APIRequest.CreateGameMsg
-	(*APIRequest_DiscoverGamesMsg)(nil),      // 7: api.APIRequest.DiscoverGamesMsg
-	(*APIRequest_JoinGameMsg)(nil),           // 8: api.APIRequest.JoinGameMsg
-	(*APIRequest_SteerSnakeMsg)(nil),         // 9: api.APIRequest.SteerSnakeMsg
-	(*APIRequest_GetGameStateMsg)(nil),       // 10: api.APIRequest.GetGameStateMsg
-	(*APIRequest_ExitGameMsg)(nil),           // 11: api.APIRequest.ExitGameMsg
-	(*APIRequest_DisconnectMsg)(nil),         // 12: api.APIRequest.DisconnectMsg
-	(*APIResponse_SuccessConnectMsg)(nil),    // 13: api.APIResponse.SuccessConnectMsg
-	(*APIResponse_AckMsg)(nil),               // 14: api.APIResponse.AckMsg
-	(*APIResponse_ErrorMsg)(nil),             // 15: api.APIResponse.ErrorMsg
-	(*APIResponse_GameListMsg)(nil),          // 16: api.APIResponse.GameListMsg
-	(*APIResponse_GameStateMsg)(nil),         // 17: api.APIResponse.GameStateMsg
-	(*APIResponse_GameListMsg_GameInfo)(nil), // 18: api.APIResponse.GameListMsg.GameInfo
-	(*APIResponse_GameStateMsg_Coord)(nil),   // 19: api.APIResponse.GameStateMsg.Coord
-	(*APIResponse_GameStateMsg_Snake)(nil),   // 20: api.APIResponse.GameStateMsg.Snake
-	(*APIResponse_GameStateMsg_Player)(nil),  // 21: api.APIResponse.GameStateMsg.Player
+	(Direction)(0),                                    // 0: api.Direction
+	(BotDifficulty)(0),                                // 1: api.BotDifficulty
+	(APIResponse_GameStateMsg_Role)(0),                // 2: api.APIResponse.GameStateMsg.Role
+	(APIResponse_GameStateMsg_FoodKind)(0),            // 3: api.APIResponse.GameStateMsg.FoodKind
+	(APIResponse_GameStateMsg_Item_Kind)(0),           // 4: api.APIResponse.GameStateMsg.Item.Kind
+	(APIResponse_GameStateMsg_Event_Type)(0),          // 5: api.APIResponse.GameStateMsg.Event.Type
+	(APIResponse_GameStateMsg_Event_DeathCause)(0),    // 6: api.APIResponse.GameStateMsg.Event.DeathCause
+	(APIResponse_GameStateMsg_Result_FinishReason)(0), // 7: api.APIResponse.GameStateMsg.Result.FinishReason
+	(*APIRequest)(nil),                                // 8: api.APIRequest
+	(*APIResponse)(nil),                               // 9: api.APIResponse
+	(*APIRequest_ConnectMsg)(nil),                     // 10: api.APIRequest.ConnectMsg
+	(*APIRequest_PingMsg)(nil),                        // 11: api.APIRequest.PingMsg
+	(*APIRequest_CreateGameMsg)(nil),                  // 12: api.APIRequest.CreateGameMsg
+	(*APIRequest_DiscoverGamesMsg)(nil),               // 13: api.APIRequest.DiscoverGamesMsg
+	(*APIRequest_JoinGameMsg)(nil),                    // 14: api.APIRequest.JoinGameMsg
+	(*APIRequest_SteerSnakeMsg)(nil),                  // 15: api.APIRequest.SteerSnakeMsg
+	(*APIRequest_GetGameStateMsg)(nil),                // 16: api.APIRequest.GetGameStateMsg
+	(*APIRequest_ExitGameMsg)(nil),                    // 17: api.APIRequest.ExitGameMsg
+	(*APIRequest_DisconnectMsg)(nil),                  // 18: api.APIRequest.DisconnectMsg
+	(*APIRequest_AddBotsMsg)(nil),                     // 19: api.APIRequest.AddBotsMsg
+	(*APIRequest_ListBotsMsg)(nil),                    // 20: api.APIRequest.ListBotsMsg
+	(*APIRequest_RemoveBotMsg)(nil),                   // 21: api.APIRequest.RemoveBotMsg
+	(*APIRequest_ListMapsMsg)(nil),                    // 22: api.APIRequest.ListMapsMsg
+	(*APIRequest_GetStatsMsg)(nil),                    // 23: api.APIRequest.GetStatsMsg
+	(*APIRequest_RestartRoundMsg)(nil),                // 24: api.APIRequest.RestartRoundMsg
+	(*APIResponse_SuccessConnectMsg)(nil),             // 25: api.APIResponse.SuccessConnectMsg
+	(*APIResponse_AckMsg)(nil),                        // 26: api.APIResponse.AckMsg
+	(*APIResponse_ErrorMsg)(nil),                      // 27: api.APIResponse.ErrorMsg
+	(*APIResponse_GameListMsg)(nil),                   // 28: api.APIResponse.GameListMsg
+	(*APIResponse_GameStateMsg)(nil),                  // 29: api.APIResponse.GameStateMsg
+	(*APIResponse_BotListMsg)(nil),                    // 30: api.APIResponse.BotListMsg
+	(*APIResponse_StatsMsg)(nil),                      // 31: api.APIResponse.StatsMsg
+	(*APIResponse_MapListMsg)(nil),                    // 32: api.APIResponse.MapListMsg
+	(*APIResponse_GameListMsg_GameInfo)(nil),          // 33: api.APIResponse.GameListMsg.GameInfo
+	(*APIResponse_GameListMsg_Team)(nil),              // 34: api.APIResponse.GameListMsg.Team
+	(*APIResponse_GameStateMsg_Coord)(nil),            // 35: api.APIResponse.GameStateMsg.Coord
+	(*APIResponse_GameStateMsg_Item)(nil),             // 36: api.APIResponse.GameStateMsg.Item
+	(*APIResponse_GameStateMsg_Effect)(nil),           // 37: api.APIResponse.GameStateMsg.Effect
+	(*APIResponse_GameStateMsg_Snake)(nil),            // 38: api.APIResponse.GameStateMsg.Snake
+	(*APIResponse_GameStateMsg_Player)(nil),           // 39: api.APIResponse.GameStateMsg.Player
+	(*APIResponse_GameStateMsg_Team)(nil),             // 40: api.APIResponse.GameStateMsg.Team
+	(*APIResponse_GameStateMsg_Event)(nil),            // 41: api.APIResponse.GameStateMsg.Event
+	(*APIResponse_GameStateMsg_Result)(nil),           // 42: api.APIResponse.GameStateMsg.Result
+	(*APIResponse_GameStateMsg_Result_Standing)(nil),  // 43: api.APIResponse.GameStateMsg.Result.Standing
+	(*APIResponse_BotListMsg_Bot)(nil),                // 44: api.APIResponse.BotListMsg.Bot
+	(*APIResponse_StatsMsg_DeathCount)(nil),           // 45: api.APIResponse.StatsMsg.DeathCount
+	(*APIResponse_StatsMsg_PlayerStats)(nil),          // 46: api.APIResponse.StatsMsg.PlayerStats
+	(*APIResponse_MapListMsg_MapInfo)(nil),            // 47: api.APIResponse.MapListMsg.MapInfo
 }
 var file_api_proto_depIdxs = []int32{
-	4,  // 0: api.APIRequest.connect:type_name -> api.APIRequest.ConnectMsg
-	5,  // 1: api.APIRequest.ping:type_name -> api.APIRequest.PingMsg
-	6,  // 2: api.APIRequest.create_game:type_name -> api.APIRequest.CreateGameMsg
-	7,  // 3: api.APIRequest.discover_games:type_name -> api.APIRequest.DiscoverGamesMsg
-	8,  // 4: api.APIRequest.join_game:type_name -> api.APIRequest.JoinGameMsg
-	9,  // 5: api.APIRequest.steer_snake:type_name -> api.APIRequest.SteerSnakeMsg
-	10, // 6: api.APIRequest.get_game_state:type_name -> api.APIRequest.GetGameStateMsg
-	11, // 7: api.APIRequest.exit_game:type_name -> api.APIRequest.ExitGameMsg
-	12, // 8: api.APIRequest.disconnect:type_name -> api.APIRequest.DisconnectMsg
-	13, // 9: api.APIResponse.successConnect:type_name -> api.APIResponse.SuccessConnectMsg
-	14, // 10: api.APIResponse.ack:type_name -> api.APIResponse.AckMsg
-	15, // 11: api.APIResponse.error:type_name -> api.APIResponse.ErrorMsg
-	16, // 12: api.APIResponse.game_list:type_name -> api.APIResponse.GameListMsg
-	17, // 13: api.APIResponse.game_state:type_name -> api.APIResponse.GameStateMsg
-	0,  // 14: api.APIRequest.SteerSnakeMsg.direction:type_name -> api.Direction
-	18, // 15: api.APIResponse.GameListMsg.games:type_name -> api.APIResponse.GameListMsg.GameInfo
-	20, // 16: api.APIResponse.GameStateMsg.snakes:type_name -> api.APIResponse.GameStateMsg.Snake
-	19, // 17: api.APIResponse.GameStateMsg.foods:type_name -> api.APIResponse.GameStateMsg.Coord
-	21, // 18: api.APIResponse.GameStateMsg.players:type_name -> api.APIResponse.GameStateMsg.Player
-	19, // 19: api.APIResponse.GameStateMsg.Snake.points:type_name -> api.APIResponse.GameStateMsg.Coord
-	0,  // 20: api.APIResponse.GameStateMsg.Snake.head_direction:type_name -> api.Direction
-	1,  // 21: api.APIResponse.GameStateMsg.Player.role:type_name -> api.APIResponse.GameStateMsg.Role
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	10, // 0: api.APIRequest.connect:type_name -> api.APIRequest.ConnectMsg
+	11, // 1: api.APIRequest.ping:type_name -> api.APIRequest.PingMsg
+	12, // 2: api.APIRequest.create_game:type_name -> api.APIRequest.CreateGameMsg
+	13, // 3: api.APIRequest.discover_games:type_name -> api.APIRequest.DiscoverGamesMsg
+	14, // 4: api.APIRequest.join_game:type_name -> api.APIRequest.JoinGameMsg
+	15, // 5: api.APIRequest.steer_snake:type_name -> api.APIRequest.SteerSnakeMsg
+	16, // 6: api.APIRequest.get_game_state:type_name -> api.APIRequest.GetGameStateMsg
+	17, // 7: api.APIRequest.exit_game:type_name -> api.APIRequest.ExitGameMsg
+	18, // 8: api.APIRequest.disconnect:type_name -> api.APIRequest.DisconnectMsg
+	19, // 9: api.APIRequest.add_bots:type_name -> api.APIRequest.AddBotsMsg
+	20, // 10: api.APIRequest.list_bots:type_name -> api.APIRequest.ListBotsMsg
+	21, // 11: api.APIRequest.remove_bot:type_name -> api.APIRequest.RemoveBotMsg
+	22, // 12: api.APIRequest.list_maps:type_name -> api.APIRequest.ListMapsMsg
+	24, // 13: api.APIRequest.restart_round:type_name -> api.APIRequest.RestartRoundMsg
+	23, // 14: api.APIRequest.get_stats:type_name -> api.APIRequest.GetStatsMsg
+	25, // 15: api.APIResponse.successConnect:type_name -> api.APIResponse.SuccessConnectMsg
+	26, // 16: api.APIResponse.ack:type_name -> api.APIResponse.AckMsg
+	27, // 17: api.APIResponse.error:type_name -> api.APIResponse.ErrorMsg
+	28, // 18: api.APIResponse.game_list:type_name -> api.APIResponse.GameListMsg
+	29, // 19: api.APIResponse.game_state:type_name -> api.APIResponse.GameStateMsg
+	30, // 20: api.APIResponse.bot_list:type_name -> api.APIResponse.BotListMsg
+	32, // 21: api.APIResponse.map_list:type_name -> api.APIResponse.MapListMsg
+	31, // 22: api.APIResponse.stats:type_name -> api.APIResponse.StatsMsg
+	35, // 23: api.APIRequest.CreateGameMsg.walls:type_name -> api.APIResponse.GameStateMsg.Coord
+	0,  // 24: api.APIRequest.SteerSnakeMsg.direction:type_name -> api.Direction
+	1,  // 25: api.APIRequest.AddBotsMsg.difficulty:type_name -> api.BotDifficulty
+	33, // 26: api.APIResponse.GameListMsg.games:type_name -> api.APIResponse.GameListMsg.GameInfo
+	38, // 27: api.APIResponse.GameStateMsg.snakes:type_name -> api.APIResponse.GameStateMsg.Snake
+	35, // 28: api.APIResponse.GameStateMsg.foods:type_name -> api.APIResponse.GameStateMsg.Coord
+	39, // 29: api.APIResponse.GameStateMsg.players:type_name -> api.APIResponse.GameStateMsg.Player
+	41, // 30: api.APIResponse.GameStateMsg.events:type_name -> api.APIResponse.GameStateMsg.Event
+	35, // 31: api.APIResponse.GameStateMsg.walls:type_name -> api.APIResponse.GameStateMsg.Coord
+	42, // 32: api.APIResponse.GameStateMsg.result:type_name -> api.APIResponse.GameStateMsg.Result
+	36, // 33: api.APIResponse.GameStateMsg.items:type_name -> api.APIResponse.GameStateMsg.Item
+	3,  // 34: api.APIResponse.GameStateMsg.food_kinds:type_name -> api.APIResponse.GameStateMsg.FoodKind
+	40, // 35: api.APIResponse.GameStateMsg.teams:type_name -> api.APIResponse.GameStateMsg.Team
+	44, // 36: api.APIResponse.BotListMsg.bots:type_name -> api.APIResponse.BotListMsg.Bot
+	46, // 37: api.APIResponse.StatsMsg.players:type_name -> api.APIResponse.StatsMsg.PlayerStats
+	47, // 38: api.APIResponse.MapListMsg.maps:type_name -> api.APIResponse.MapListMsg.MapInfo
+	34, // 39: api.APIResponse.GameListMsg.GameInfo.teams:type_name -> api.APIResponse.GameListMsg.Team
+	4,  // 40: api.APIResponse.GameStateMsg.Item.kind:type_name -> api.APIResponse.GameStateMsg.Item.Kind
+	35, // 41: api.APIResponse.GameStateMsg.Item.coord:type_name -> api.APIResponse.GameStateMsg.Coord
+	4,  // 42: api.APIResponse.GameStateMsg.Effect.kind:type_name -> api.APIResponse.GameStateMsg.Item.Kind
+	35, // 43: api.APIResponse.GameStateMsg.Snake.points:type_name -> api.APIResponse.GameStateMsg.Coord
+	0,  // 44: api.APIResponse.GameStateMsg.Snake.head_direction:type_name -> api.Direction
+	37, // 45: api.APIResponse.GameStateMsg.Snake.effects:type_name -> api.APIResponse.GameStateMsg.Effect
+	2,  // 46: api.APIResponse.GameStateMsg.Player.role:type_name -> api.APIResponse.GameStateMsg.Role
+	5,  // 47: api.APIResponse.GameStateMsg.Event.type:type_name -> api.APIResponse.GameStateMsg.Event.Type
+	35, // 48: api.APIResponse.GameStateMsg.Event.coord:type_name -> api.APIResponse.GameStateMsg.Coord
+	6,  // 49: api.APIResponse.GameStateMsg.Event.cause:type_name -> api.APIResponse.GameStateMsg.Event.DeathCause
+	4,  // 50: api.APIResponse.GameStateMsg.Event.item:type_name -> api.APIResponse.GameStateMsg.Item.Kind
+	7,  // 51: api.APIResponse.GameStateMsg.Result.reason:type_name -> api.APIResponse.GameStateMsg.Result.FinishReason
+	43, // 52: api.APIResponse.GameStateMsg.Result.standings:type_name -> api.APIResponse.GameStateMsg.Result.Standing
+	1,  // 53: api.APIResponse.BotListMsg.Bot.difficulty:type_name -> api.BotDifficulty
+	6,  // 54: api.APIResponse.StatsMsg.DeathCount.cause:type_name -> api.APIResponse.GameStateMsg.Event.DeathCause
+	45, // 55: api.APIResponse.StatsMsg.PlayerStats.death_causes:type_name -> api.APIResponse.StatsMsg.DeathCount
+	56, // [56:56] is the sub-list for method output_type
+	56, // [56:56] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_init() }
@@ -1819,7 +4194,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIResponse_SuccessConnectMsg); i {
+			switch v := v.(*APIRequest_AddBotsMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1831,7 +4206,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIResponse_AckMsg); i {
+			switch v := v.(*APIRequest_ListBotsMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1843,7 +4218,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIResponse_ErrorMsg); i {
+			switch v := v.(*APIRequest_RemoveBotMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1855,7 +4230,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIResponse_GameListMsg); i {
+			switch v := v.(*APIRequest_ListMapsMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1867,7 +4242,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIResponse_GameStateMsg); i {
+			switch v := v.(*APIRequest_GetStatsMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1879,7 +4254,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIResponse_GameListMsg_GameInfo); i {
+			switch v := v.(*APIRequest_RestartRoundMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1891,7 +4266,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIResponse_GameStateMsg_Coord); i {
+			switch v := v.(*APIResponse_SuccessConnectMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1903,7 +4278,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIResponse_GameStateMsg_Snake); i {
+			switch v := v.(*APIResponse_AckMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1915,6 +4290,150 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_ErrorMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameListMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameStateMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_BotListMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_StatsMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_MapListMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameListMsg_GameInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameListMsg_Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameStateMsg_Coord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameStateMsg_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameStateMsg_Effect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameStateMsg_Snake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*APIResponse_GameStateMsg_Player); i {
 			case 0:
 				return &v.state
@@ -1926,6 +4445,102 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
+		file_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameStateMsg_Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameStateMsg_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameStateMsg_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_GameStateMsg_Result_Standing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_BotListMsg_Bot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_StatsMsg_DeathCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_StatsMsg_PlayerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse_MapListMsg_MapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_api_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*APIRequest_Connect)(nil),
@@ -1937,6 +4552,12 @@ func file_api_proto_init() {
 		(*APIRequest_GetGameState)(nil),
 		(*APIRequest_ExitGame)(nil),
 		(*APIRequest_Disconnect)(nil),
+		(*APIRequest_AddBots)(nil),
+		(*APIRequest_ListBots)(nil),
+		(*APIRequest_RemoveBot)(nil),
+		(*APIRequest_ListMaps)(nil),
+		(*APIRequest_RestartRound)(nil),
+		(*APIRequest_GetStats)(nil),
 	}
 	file_api_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*APIResponse_SuccessConnect)(nil),
@@ -1944,14 +4565,17 @@ func file_api_proto_init() {
 		(*APIResponse_Error)(nil),
 		(*APIResponse_GameList)(nil),
 		(*APIResponse_GameState)(nil),
+		(*APIResponse_BotList)(nil),
+		(*APIResponse_MapList)(nil),
+		(*APIResponse_Stats)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   20,
+			NumEnums:      8,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -154,6 +154,18 @@ func (server *Server) handleMessage(request *protocol.APIRequest, addr *net.UDPA
 		server.handleExitGame(request.GetExitGame(), addr)
 	case *protocol.APIRequest_Disconnect:
 		server.handleDisconnect(request.GetDisconnect(), addr)
+	case *protocol.APIRequest_AddBots:
+		server.handleAddBots(request.GetAddBots(), addr)
+	case *protocol.APIRequest_ListBots:
+		server.handleListBots(request.GetListBots(), addr)
+	case *protocol.APIRequest_RemoveBot:
+		server.handleRemoveBot(request.GetRemoveBot(), addr)
+	case *protocol.APIRequest_ListMaps:
+		server.handleListMaps(request.GetListMaps(), addr)
+	case *protocol.APIRequest_RestartRound:
+		server.handleRestartRound(request.GetRestartRound(), addr)
+	case *protocol.APIRequest_GetStats:
+		server.handleGetStats(request.GetGetStats(), addr)
 	default:
 		server.sendError(unrecognizedRequestError, addr)
 	}
@@ -192,14 +204,8 @@ func (server *Server) handleCreateGame(request *protocol.APIRequest_CreateGameMs
 	}
 	server.lastRequestTime = time.Now()
 
-	err := server.node.CreateGame(
-		request.GetGameName(),
-		request.GetWidth(),
-		request.GetHeight(),
-		request.GetFoodStatic(),
-		request.GetStateDelayMs(),
-		request.GetPlayerName(),
-	)
+	err := server.node.CreateGame(request.GetGameName(), request.GetMapName(), request.GetPlayerName(),
+		protocol.MapToP2PGameConfig(request))
 	if err == nil {
 		server.sendAck(addr)
 	} else {
@@ -225,7 +231,8 @@ func (server *Server) handleJoinGame(request *protocol.APIRequest_JoinGameMsg, a
 	}
 	server.lastRequestTime = time.Now()
 
-	err := server.node.JoinGame(request.GetGameName(), request.GetPlayerName(), request.GetIsPlayer())
+	err := server.node.JoinGame(request.GetGameName(), request.GetPlayerName(), request.GetIsPlayer(),
+		request.GetTeam())
 	if err == nil {
 		server.sendAck(addr)
 	} else {
@@ -294,3 +301,97 @@ func (server *Server) handleDisconnect(request *protocol.APIRequest_DisconnectMs
 		server.sendError(err.Error(), addr)
 	}
 }
+
+func (server *Server) handleAddBots(request *protocol.APIRequest_AddBotsMsg, addr *net.UDPAddr) {
+	if request.GetToken() != server.token {
+		server.sendError(notValidTokenError, addr)
+		return
+	}
+	server.lastRequestTime = time.Now()
+
+	robotDtos, err := server.node.AddRobots(request.GetCount(), protocol.MapToStrategyName(request.GetDifficulty()))
+	if err != nil {
+		server.sendError(err.Error(), addr)
+		return
+	}
+
+	server.sendBotList(robotDtos, addr)
+}
+
+func (server *Server) handleListBots(request *protocol.APIRequest_ListBotsMsg, addr *net.UDPAddr) {
+	if request.GetToken() != server.token {
+		server.sendError(notValidTokenError, addr)
+		return
+	}
+	server.lastRequestTime = time.Now()
+
+	robotDtos, err := server.node.GetRobots()
+	if err != nil {
+		server.sendError(err.Error(), addr)
+		return
+	}
+
+	server.sendBotList(robotDtos, addr)
+}
+
+func (server *Server) handleRemoveBot(request *protocol.APIRequest_RemoveBotMsg, addr *net.UDPAddr) {
+	if request.GetToken() != server.token {
+		server.sendError(notValidTokenError, addr)
+		return
+	}
+	server.lastRequestTime = time.Now()
+
+	err := server.node.RemoveRobot(request.GetPlayerId())
+	if err == nil {
+		server.sendAck(addr)
+	} else {
+		server.sendError(err.Error(), addr)
+	}
+}
+
+func (server *Server) handleListMaps(request *protocol.APIRequest_ListMapsMsg, addr *net.UDPAddr) {
+	if request.GetToken() != server.token {
+		server.sendError(notValidTokenError, addr)
+		return
+	}
+	server.lastRequestTime = time.Now()
+
+	mapDtos, err := server.node.GetMaps()
+	if err != nil {
+		server.sendError(err.Error(), addr)
+		return
+	}
+
+	server.sendMapList(mapDtos, addr)
+}
+
+func (server *Server) handleRestartRound(request *protocol.APIRequest_RestartRoundMsg, addr *net.UDPAddr) {
+	if request.GetToken() != server.token {
+		server.sendError(notValidTokenError, addr)
+		return
+	}
+	server.lastRequestTime = time.Now()
+
+	err := server.node.RestartRound(request.GetKeepScores())
+	if err == nil {
+		server.sendAck(addr)
+	} else {
+		server.sendError(err.Error(), addr)
+	}
+}
+
+func (server *Server) handleGetStats(request *protocol.APIRequest_GetStatsMsg, addr *net.UDPAddr) {
+	if request.GetToken() != server.token {
+		server.sendError(notValidTokenError, addr)
+		return
+	}
+	server.lastRequestTime = time.Now()
+
+	statsDtos, err := server.node.GetStats()
+	if err != nil {
+		server.sendError(err.Error(), addr)
+		return
+	}
+
+	server.sendStats(statsDtos, addr)
+}
@@ -14,8 +14,10 @@ type P2PMulticastConfig struct {
 }
 
 type P2PConfig struct {
-	Delay     int                `mapstructure:"delay"`
-	Multicast P2PMulticastConfig `mapstructure:"multicast"`
+	Delay          int                `mapstructure:"delay"`
+	ReclaimTimeout int                `mapstructure:"reclaim_timeout"`
+	MapsDir        string             `mapstructure:"maps_dir"`
+	Multicast      P2PMulticastConfig `mapstructure:"multicast"`
 }
 
 type APIConfig struct {
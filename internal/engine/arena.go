@@ -0,0 +1,86 @@
+package engine
+
+// The arena does not shrink below this size, so that new snakes still have room
+const minArenaSize = 5
+
+func (g *Game) SetShrinkInterval(shrinkInterval int32) {
+	g.ShrinkInterval = shrinkInterval
+	g.NextShrinkTurn = 0
+	if shrinkInterval > 0 && g.canShrink() {
+		g.NextShrinkTurn = g.Turn + shrinkInterval
+	}
+}
+
+func (g *Game) SetArena(arenaLevel int32, nextShrinkTurn int32) {
+	g.ArenaLevel = arenaLevel
+	g.NextShrinkTurn = nextShrinkTurn
+	g.rebuildField()
+}
+
+// Returns the cells of the border rings that have become walls
+func (g *Game) ArenaWalls() []Coord {
+	walls := make([]Coord, 0)
+	for y := int32(0); y < g.Height; y++ {
+		for x := int32(0); x < g.Width; x++ {
+			if coord := (Coord{x: x, y: y}); g.isArenaWall(coord) {
+				walls = append(walls, coord)
+			}
+		}
+	}
+	return walls
+}
+
+func (g *Game) isArenaWall(coord Coord) bool {
+	return minOf(coord.x, g.Width-1-coord.x, coord.y, g.Height-1-coord.y) < g.ArenaLevel
+}
+
+func (g *Game) canShrink() bool {
+	return g.Width-2*(g.ArenaLevel+1) >= minArenaSize && g.Height-2*(g.ArenaLevel+1) >= minArenaSize
+}
+
+// Turns the next border ring into walls when it is time, returns the deaths of the snakes caught by the walls and
+// saves their cells
+func (g *Game) shrinkArena(deadSnakePoints map[int32][]Coord) []Event {
+	if g.NextShrinkTurn == 0 || g.NextShrinkTurn > g.Turn {
+		return []Event{}
+	}
+	g.ArenaLevel++
+	g.NextShrinkTurn = 0
+	if g.canShrink() {
+		g.NextShrinkTurn = g.Turn + g.ShrinkInterval
+	}
+
+	// Snakes having any cell on the new walls die
+	deaths := make([]Event, 0)
+	for _, playerId := range g.snakeIds() {
+		points := g.Snakes[playerId].convertToPoints(g.Width, g.Height)
+		for _, point := range points {
+			if g.isArenaWall(point) {
+				deaths = append(deaths, NewSnakeDiedEvent(playerId, point, WALL, []int32{}))
+				deadSnakePoints[playerId] = points
+				delete(g.Snakes, playerId)
+				break
+			}
+		}
+	}
+	g.events = append(g.events, deaths...)
+
+	// Food and items under the new walls disappear
+	foods := make([]Food, 0, len(g.Foods))
+	for _, food := range g.Foods {
+		if !g.isArenaWall(food.Coord) {
+			foods = append(foods, food)
+		}
+	}
+	g.Foods = foods
+	items := make([]Item, 0, len(g.Items))
+	for _, item := range g.Items {
+		if !g.isArenaWall(item.Coord) {
+			items = append(items, item)
+		}
+	}
+	g.Items = items
+
+	g.rebuildField()
+	return deaths
+}
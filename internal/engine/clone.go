@@ -0,0 +1,80 @@
+package engine
+
+import (
+	"math/rand"
+)
+
+// Returns a deep copy of the game to look ahead without changing the game. The copy has its own random source, so
+// the food and items spawned in it differ from the ones spawned in the game
+func (g *Game) Clone() *Game {
+	clone := *g
+	clone.random = rand.New(rand.NewSource(g.Seed + int64(g.Turn)))
+
+	clone.Walls = append([]Coord{}, g.Walls...)
+	clone.SpawnPoints = append([]Coord{}, g.SpawnPoints...)
+	clone.FoodSpots = append([]Coord{}, g.FoodSpots...)
+	clone.FoodWeights = make(map[FoodKind]int32, len(g.FoodWeights))
+	for kind, weight := range g.FoodWeights {
+		clone.FoodWeights[kind] = weight
+	}
+
+	clone.Snakes = make(map[int32]*Snake, len(g.Snakes))
+	for playerId, snake := range g.Snakes {
+		clone.Snakes[playerId] = snake.clone()
+	}
+	clone.Players = make(map[int32]*Player, len(g.Players))
+	for playerId, player := range g.Players {
+		clone.Players[playerId] = player.clone()
+	}
+	clone.Foods = append([]Food{}, g.Foods...)
+	clone.Items = append([]Item{}, g.Items...)
+	clone.TeamScores = make(map[int32]int32, len(g.TeamScores))
+	for team, score := range g.TeamScores {
+		clone.TeamScores[team] = score
+	}
+
+	clone.field = g.field.clone()
+	clone.events = make([]Event, len(g.events))
+	for idx, event := range g.events {
+		clone.events[idx] = event
+		clone.events[idx].KillerIds = append([]int32{}, event.KillerIds...)
+	}
+	return &clone
+}
+
+func (s *Snake) clone() *Snake {
+	clone := *s
+	clone.Points = append([]Coord{}, s.Points...)
+	clone.Effects = append([]Effect{}, s.Effects...)
+	if s.Owner != nil {
+		owner := *s.Owner
+		if s.Owner.Stats != nil {
+			owner.Stats = s.Owner.Stats.clone()
+		}
+		clone.Owner = &owner
+	}
+	return &clone
+}
+
+func (p *Player) clone() *Player {
+	clone := *p
+	if p.Stats != nil {
+		clone.Stats = p.Stats.clone()
+	}
+	return &clone
+}
+
+func (s *PlayerStats) clone() *PlayerStats {
+	clone := *s
+	clone.DeathCauses = make(map[DeathCause]int32, len(s.DeathCauses))
+	for cause, count := range s.DeathCauses {
+		clone.DeathCauses[cause] = count
+	}
+	return &clone
+}
+
+func (f *field) clone() *field {
+	clone := *f
+	clone.cells = append([]cell{}, f.cells...)
+	return &clone
+}
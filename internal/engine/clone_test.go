@@ -0,0 +1,93 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Everything the queries can tell about the game
+type gameView struct {
+	cells      map[Coord]CellContent
+	owners     map[Coord]int32
+	bodies     map[int32][]Coord
+	neighbours map[int32]map[Direction]Coord
+	foods      []Coord
+	stats      map[int32]PlayerStats
+	ownerStats map[int32]PlayerStats
+	turn       int32
+}
+
+func viewGame(g *Game) gameView {
+	view := gameView{
+		cells:      make(map[Coord]CellContent),
+		owners:     make(map[Coord]int32),
+		bodies:     make(map[int32][]Coord),
+		neighbours: make(map[int32]map[Direction]Coord),
+		foods:      g.FoodCells(),
+		stats:      make(map[int32]PlayerStats),
+		ownerStats: make(map[int32]PlayerStats),
+		turn:       g.Turn,
+	}
+	for y := int32(0); y < g.Height; y++ {
+		for x := int32(0); x < g.Width; x++ {
+			coord := NewCoord(x, y)
+			view.cells[coord], view.owners[coord] = g.Cell(coord)
+		}
+	}
+	for playerId, snake := range g.Snakes {
+		view.bodies[playerId], _ = g.SnakeBody(playerId)
+		view.neighbours[playerId] = g.FreeNeighbours(playerId)
+		if snake.Owner != nil && snake.Owner.Stats != nil {
+			view.ownerStats[playerId] = *snake.Owner.Stats.clone()
+		}
+	}
+	for playerId, player := range g.Players {
+		view.stats[playerId] = *player.Stats.clone()
+	}
+	return view
+}
+
+func newCloneTestGame(t *testing.T) *Game {
+	t.Helper()
+
+	g := NewGame("clone", 20, 20, 3, 1)
+	g.ReclaimTurns = 10
+	for playerId := int32(1); playerId <= 3; playerId++ {
+		if err := g.AddPlayer(playerId, "player", "hash", 0, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g.Players[1].Stats.DeathCauses[SELF] = 1
+	g.DeletePlayer(3)
+	g.NextState(map[int32]Direction{})
+	return g
+}
+
+func TestCloneAnswersLikeOriginal(t *testing.T) {
+	g := newCloneTestGame(t)
+	if original, clone := viewGame(g), viewGame(g.Clone()); !reflect.DeepEqual(original, clone) {
+		t.Errorf("clone = %+v, want %+v", clone, original)
+	}
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+	g := newCloneTestGame(t)
+	original := viewGame(g)
+
+	clone := g.Clone()
+	clone.Players[1].Stats.FoodEaten += 10
+	clone.Players[1].Stats.DeathCauses[SELF]++
+	clone.Snakes[3].Owner.Stats.Kills++
+	clone.Snakes[2].Points[0] = NewCoord(0, 0)
+	clone.DeletePlayer(2)
+	if err := clone.AddPlayer(4, "new player", "", 0, true); err != nil {
+		t.Fatal(err)
+	}
+	for turn := 0; turn < 10; turn++ {
+		clone.NextState(map[int32]Direction{1: LEFT, 4: UP})
+	}
+
+	if got := viewGame(g); !reflect.DeepEqual(got, original) {
+		t.Errorf("original after changing the clone = %+v, want %+v", got, original)
+	}
+}
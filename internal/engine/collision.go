@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"sort"
+)
+
+func (g *Game) findDeaths(snakeIds []int32, borderCrashes map[int32]Coord) []Event {
+	// Group snakes by the cell their heads moved to
+	heads := make(map[Coord][]int32)
+	for _, playerId := range snakeIds {
+		if _, ok := borderCrashes[playerId]; ok {
+			continue
+		}
+		head := g.Snakes[playerId].Points[0]
+		heads[head] = append(heads[head], playerId)
+	}
+
+	deaths := make([]Event, 0)
+	for _, playerId := range snakeIds {
+		// The snake crashed into the field edge
+		if crash, ok := borderCrashes[playerId]; ok {
+			deaths = append(deaths, NewSnakeDiedEvent(playerId, crash, WALL, []int32{}))
+			continue
+		}
+
+		head := g.Snakes[playerId].Points[0]
+		cell := g.field.get(head)
+
+		// The snake crashed into the wall
+		if cell.state == cellState_WALL {
+			deaths = append(deaths, NewSnakeDiedEvent(playerId, head, WALL, []int32{}))
+			continue
+		}
+
+		// The snake hit itself
+		if cell.state == cellState_SNAKE && cell.owner == playerId {
+			deaths = append(deaths, NewSnakeDiedEvent(playerId, head, SELF, []int32{}))
+			continue
+		}
+
+		// The ghost snake passes through other snakes
+		if g.Snakes[playerId].HasEffect(GHOST) {
+			continue
+		}
+
+		// The snake hit other snake or the heads that moved to the same cell (the bodies of teammates are
+		// passed through if friendly collisions are not lethal)
+		cause := HEAD_ON
+		killerIds := make([]int32, 0)
+		if cell.state == cellState_SNAKE && !(g.SafeTeammates && g.areTeammates(playerId, cell.owner)) {
+			cause = SNAKE
+			killerIds = append(killerIds, cell.owner)
+		}
+		for _, otherPlayerId := range heads[head] {
+			if otherPlayerId != playerId && otherPlayerId != cell.owner {
+				killerIds = append(killerIds, otherPlayerId)
+			}
+		}
+		if len(killerIds) > 0 {
+			sort.Slice(killerIds, func(i, j int) bool { return killerIds[i] < killerIds[j] })
+			deaths = append(deaths, NewSnakeDiedEvent(playerId, head, cause, killerIds))
+		}
+	}
+
+	return deaths
+}
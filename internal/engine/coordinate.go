@@ -19,3 +19,20 @@ func (c Coord) X() int32 {
 func (c Coord) Y() int32 {
 	return c.y
 }
+
+func abs(value int32) int32 {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+func minOf(values ...int32) int32 {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package engine
+
+type EventType int
+
+const (
+	FOOD_EATEN      EventType = 1
+	SNAKE_DIED      EventType = 2
+	POINTS_AWARDED  EventType = 3
+	FOOD_SPAWNED    EventType = 4
+	ZOMBIE_CREATED  EventType = 5
+	GAME_FINISHED   EventType = 6
+	ITEM_SPAWNED    EventType = 7
+	ITEM_PICKED_UP  EventType = 8
+	SNAKE_RESPAWNED EventType = 9
+)
+
+type DeathCause int
+
+const (
+	NO_CAUSE DeathCause = 0
+	SELF     DeathCause = 1 // The snake hit its own body
+	SNAKE    DeathCause = 2 // The snake hit the body of other snake
+	HEAD_ON  DeathCause = 3 // The snake head moved to the same cell as other heads
+	WALL     DeathCause = 4 // The snake crashed into the wall or the field edge
+)
+
+type Event struct {
+	Type      EventType
+	PlayerId  int32      // Owner of the snake (or the player) the event is about, winner for GAME_FINISHED
+	Coord     Coord      // Cell where the event happened
+	Cause     DeathCause // Cause of the death (SNAKE_DIED only)
+	KillerIds []int32    // Owners of the snakes that were hit (SNAKE_DIED only)
+	Points    int32      // Awarded points (POINTS_AWARDED only)
+	Item      ItemKind   // Kind of the item (ITEM_SPAWNED and ITEM_PICKED_UP only)
+}
+
+func NewFoodEatenEvent(playerId int32, coord Coord) Event {
+	return Event{
+		Type:     FOOD_EATEN,
+		PlayerId: playerId,
+		Coord:    coord,
+	}
+}
+
+func NewSnakeDiedEvent(playerId int32, coord Coord, cause DeathCause, killerIds []int32) Event {
+	return Event{
+		Type:      SNAKE_DIED,
+		PlayerId:  playerId,
+		Coord:     coord,
+		Cause:     cause,
+		KillerIds: killerIds,
+	}
+}
+
+func NewPointsAwardedEvent(playerId int32, coord Coord, points int32) Event {
+	return Event{
+		Type:     POINTS_AWARDED,
+		PlayerId: playerId,
+		Coord:    coord,
+		Points:   points,
+	}
+}
+
+func NewFoodSpawnedEvent(coord Coord) Event {
+	return Event{
+		Type:  FOOD_SPAWNED,
+		Coord: coord,
+	}
+}
+
+func NewZombieCreatedEvent(playerId int32, coord Coord) Event {
+	return Event{
+		Type:     ZOMBIE_CREATED,
+		PlayerId: playerId,
+		Coord:    coord,
+	}
+}
+
+func NewGameFinishedEvent(winnerId int32) Event {
+	return Event{
+		Type:     GAME_FINISHED,
+		PlayerId: winnerId,
+	}
+}
+
+func NewItemSpawnedEvent(coord Coord, kind ItemKind) Event {
+	return Event{
+		Type:  ITEM_SPAWNED,
+		Coord: coord,
+		Item:  kind,
+	}
+}
+
+func NewItemPickedUpEvent(playerId int32, coord Coord, kind ItemKind) Event {
+	return Event{
+		Type:     ITEM_PICKED_UP,
+		PlayerId: playerId,
+		Coord:    coord,
+		Item:     kind,
+	}
+}
+
+func NewSnakeRespawnedEvent(playerId int32, coord Coord) Event {
+	return Event{
+		Type:     SNAKE_RESPAWNED,
+		PlayerId: playerId,
+		Coord:    coord,
+	}
+}
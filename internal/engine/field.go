@@ -11,43 +11,142 @@ const (
 	cellState_EMPTY cellState = 0
 	cellState_FOOD  cellState = 1
 	cellState_SNAKE cellState = 2
+	cellState_WALL  cellState = 3
+	cellState_ITEM  cellState = 4
 )
 
+const defaultSpawnDistance = 4
+
 var (
 	headPlaceNotFoundError = errors.New("place for snake head not found")
 	tailPlaceNotFoundError = errors.New("place for snake tail not found")
 )
 
-func (g *Game) createField() [][]cellState {
-	// Init
-	field := make([][]cellState, g.Height)
-	for i := int32(0); i < g.Height; i++ {
-		field[i] = make([]cellState, g.Width)
+type cell struct {
+	state cellState
+	owner int32 // Owner of the snake occupying the cell
+}
+
+// Occupancy grid of the game, it is updated incrementally as snakes move and food is eaten or spawned
+type field struct {
+	width      int32
+	height     int32
+	walled     bool // The field edges are walls
+	cells      []cell
+	emptyCount int32
+	overlaps   bool // Some cells are occupied by several snakes (after ghost snakes passed through them)
+}
+
+func newField(width int32, height int32, walled bool) *field {
+	return &field{
+		width:      width,
+		height:     height,
+		walled:     walled,
+		cells:      make([]cell, width*height),
+		emptyCount: width * height,
 	}
+}
 
-	// Add snakes
-	for _, snake := range g.Snakes {
-		points := snake.convertToPoints(g.Width, g.Height)
-		for _, point := range points {
-			field[point.y][point.x] = cellState_SNAKE
+func (f *field) get(coord Coord) cell {
+	return f.cells[coord.y*f.width+coord.x]
+}
+
+func (f *field) set(coord Coord, state cellState, owner int32) {
+	idx := coord.y*f.width + coord.x
+	if f.cells[idx].state == cellState_EMPTY && state != cellState_EMPTY {
+		f.emptyCount--
+	} else if f.cells[idx].state != cellState_EMPTY && state == cellState_EMPTY {
+		f.emptyCount++
+	}
+	f.cells[idx] = cell{
+		state: state,
+		owner: owner,
+	}
+}
+
+func (f *field) setEmpty(coord Coord) {
+	f.set(coord, cellState_EMPTY, 0)
+}
+
+func (f *field) setFood(coord Coord) {
+	f.set(coord, cellState_FOOD, 0)
+}
+
+func (f *field) setSnake(coord Coord, owner int32) {
+	f.set(coord, cellState_SNAKE, owner)
+}
+
+func (f *field) setWall(coord Coord) {
+	f.set(coord, cellState_WALL, 0)
+}
+
+func (f *field) setItem(coord Coord) {
+	f.set(coord, cellState_ITEM, 0)
+}
+
+func (f *field) isEmpty(coord Coord) bool {
+	return f.get(coord).state == cellState_EMPTY
+}
+
+func (f *field) neighbour(coord Coord, offsetX int32, offsetY int32) Coord {
+	return Coord{
+		x: ((coord.x+offsetX)%f.width + f.width) % f.width,
+		y: ((coord.y+offsetY)%f.height + f.height) % f.height,
+	}
+}
+
+func (f *field) emptyCells() []Coord {
+	emptyCells := make([]Coord, 0, f.emptyCount)
+	for y := int32(0); y < f.height; y++ {
+		for x := int32(0); x < f.width; x++ {
+			if f.cells[y*f.width+x].state == cellState_EMPTY {
+				emptyCells = append(emptyCells, Coord{x: x, y: y})
+			}
+		}
+	}
+
+	return emptyCells
+}
+
+func (g *Game) rebuildField() {
+	g.field = newField(g.Width, g.Height, g.Walled)
+
+	// Add walls
+	for _, coord := range g.Walls {
+		g.field.setWall(coord)
+	}
+	for _, coord := range g.ArenaWalls() {
+		g.field.setWall(coord)
+	}
+
+	// Add snakes (in a fixed order, ghost snakes may share cells with other snakes)
+	for _, playerId := range g.snakeIds() {
+		for _, point := range g.Snakes[playerId].convertToPoints(g.Width, g.Height) {
+			if g.field.get(point).state == cellState_SNAKE {
+				g.field.overlaps = true
+			}
+			g.field.setSnake(point, playerId)
 		}
 	}
 
 	// Add foods
-	for _, coord := range g.Foods {
-		field[coord.y][coord.x] = cellState_FOOD
+	for _, food := range g.Foods {
+		g.field.setFood(food.Coord)
 	}
 
-	return field
+	// Add items
+	for _, item := range g.Items {
+		g.field.setItem(item.Coord)
+	}
 }
 
-func createSnake(field [][]cellState) ([]Coord, error) {
-	headCell, err := findHeadCell(field)
+func (g *Game) createSnake() ([]Coord, error) {
+	headCell, err := g.findHeadCell()
 	if err != nil {
 		return nil, err
 	}
 
-	tailCell, err := findTailCell(field, headCell)
+	tailCell, err := findTailCell(g.field, headCell, g.random)
 	if err != nil {
 		return nil, err
 	}
@@ -55,82 +154,200 @@ func createSnake(field [][]cellState) ([]Coord, error) {
 	return []Coord{headCell, tailCell}, nil
 }
 
-func createFoods(field [][]cellState, count int32) []Coord {
+func createFoods(field *field, count int32, random *rand.Rand) []Coord {
 	if count <= 0 {
 		return []Coord{}
 	}
 
-	emptyCells := getAllEmptyCells(field)
-	if int32(len(emptyCells)) <= count {
-		return emptyCells
+	if field.emptyCount <= count {
+		foodCells := field.emptyCells()
+		for _, foodCell := range foodCells {
+			field.setFood(foodCell)
+		}
+		return foodCells
 	}
 
 	foodCells := make([]Coord, count)
-	for i := int32(0); i < count; i++ {
-		cellIdx := rand.Intn(len(emptyCells))
-		foodCells[i] = emptyCells[cellIdx]
-		emptyCells = append(emptyCells[:cellIdx], emptyCells[cellIdx+1:]...)
+	if field.emptyCount*4 < field.width*field.height {
+		// The field is almost full, so choose among the empty cells
+		emptyCells := field.emptyCells()
+		for i := int32(0); i < count; i++ {
+			cellIdx := random.Intn(len(emptyCells))
+			foodCells[i] = emptyCells[cellIdx]
+			emptyCells = append(emptyCells[:cellIdx], emptyCells[cellIdx+1:]...)
+			field.setFood(foodCells[i])
+		}
+		return foodCells
 	}
 
+	// Pick random cells until an empty one is found
+	for i := int32(0); i < count; {
+		cellIdx := random.Int31n(field.width * field.height)
+		coord := Coord{x: cellIdx % field.width, y: cellIdx / field.width}
+		if field.isEmpty(coord) {
+			foodCells[i] = coord
+			field.setFood(coord)
+			i++
+		}
+	}
 	return foodCells
 }
 
-func findHeadCell(field [][]cellState) (Coord, error) {
-	height := int32(len(field))
-	width := int32(len(field[0]))
-	emptyCells := getAllEmptyCells(field)
-
-	// Checking that the 5*5 area centered at the selected point does not contain other snakes
-	for _, cell := range emptyCells {
-		check := true
-		for k := int32(-2); k <= 2 && check; k++ {
-			neighbourY := (cell.y + k + height) % height
-			for l := int32(-2); l <= 2 && check; l++ {
-				neighbourX := (cell.x + l + width) % width
-				if field[neighbourY][neighbourX] == cellState_SNAKE {
-					check = false
-				}
-			}
+func findEmptyCell(field *field, random *rand.Rand) (Coord, bool) {
+	if field.emptyCount == 0 {
+		return Coord{}, false
+	}
+
+	if field.emptyCount*4 < field.width*field.height {
+		// The field is almost full, so choose among the empty cells
+		emptyCells := field.emptyCells()
+		return emptyCells[random.Intn(len(emptyCells))], true
+	}
+
+	// Pick random cells until an empty one is found
+	for {
+		cellIdx := random.Int31n(field.width * field.height)
+		if coord := (Coord{x: cellIdx % field.width, y: cellIdx / field.width}); field.isEmpty(coord) {
+			return coord, true
+		}
+	}
+}
+
+func createFoodsFromSnake(field *field, snakePoints []Coord, random *rand.Rand) []Coord {
+	foodCells := make([]Coord, 0)
+	for _, point := range snakePoints {
+		// Each cell of a dead snake becomes food with probability 0.5
+		if field.isEmpty(point) && random.Intn(2) == 0 {
+			field.setFood(point)
+			foodCells = append(foodCells, point)
 		}
+	}
+
+	return foodCells
+}
 
-		if check {
-			return cell, nil
+// Chooses a random cell for the head of a new snake among the spawn cells, spawn points of the map are preferred
+func (g *Game) findHeadCell() (Coord, error) {
+	for _, candidates := range [][]Coord{g.SpawnPoints, g.field.allCells()} {
+		headCells := make([]Coord, 0)
+		for _, cell := range candidates {
+			if g.isSpawnCell(cell) {
+				headCells = append(headCells, cell)
+			}
+		}
+		if len(headCells) > 0 {
+			return headCells[g.random.Intn(len(headCells))], nil
 		}
 	}
 
 	return Coord{}, headPlaceNotFoundError
 }
 
-func findTailCell(field [][]cellState, headCell Coord) (Coord, error) {
-	height := int32(len(field))
-	width := int32(len(field[0]))
+// Checks that a new snake can get the head at the cell: the 5*5 area around it is free, it is far from the heads of
+// other snakes and out of their way, and there is a free cell for the tail
+func (g *Game) isSpawnCell(cell Coord) bool {
+	return isHeadCell(g.field, cell) && !g.isNearHeads(cell) && hasTailCell(g.field, cell)
+}
+
+// Checks that the cell is close to the head of other snake or lies in its way
+func (g *Game) isNearHeads(cell Coord) bool {
+	for _, snake := range g.Snakes {
+		head := snake.Points[0]
+		if g.Distance(cell, head) <= g.SpawnDistance {
+			return true
+		}
 
-	// Check that the snake's tail will occupy a cell free of food
-	availableTailCoords := []Coord{
-		{0, 1}, {1, 0}, {0, -1}, {-1, 0},
+		// The cells ahead of the head in the band of the 5*5 area
+		var ahead, aside int32
+		switch snake.HeadDirection {
+		case UP:
+			ahead, aside = head.y-cell.y, g.Distance(Coord{x: head.x}, Coord{x: cell.x})
+		case DOWN:
+			ahead, aside = cell.y-head.y, g.Distance(Coord{x: head.x}, Coord{x: cell.x})
+		case LEFT:
+			ahead, aside = head.x-cell.x, g.Distance(Coord{y: head.y}, Coord{y: cell.y})
+		case RIGHT:
+			ahead, aside = cell.x-head.x, g.Distance(Coord{y: head.y}, Coord{y: cell.y})
+		}
+		if !g.Walled {
+			size := g.Width
+			if snake.HeadDirection == UP || snake.HeadDirection == DOWN {
+				size = g.Height
+			}
+			ahead = (ahead%size + size) % size
+		}
+		if ahead > 0 && ahead <= 2*g.SpawnDistance && aside <= 2 {
+			return true
+		}
 	}
-	for _, availableTailCoord := range availableTailCoords {
-		tailCellY := (headCell.y + availableTailCoord.y + height) % height
-		tailCellX := (headCell.x + availableTailCoord.x + width) % width
-		if field[tailCellY][tailCellX] != cellState_FOOD {
-			return availableTailCoord, nil
+	return false
+}
+
+func (f *field) allCells() []Coord {
+	cells := make([]Coord, 0, f.width*f.height)
+	for y := int32(0); y < f.height; y++ {
+		for x := int32(0); x < f.width; x++ {
+			cells = append(cells, Coord{x: x, y: y})
 		}
 	}
-	return Coord{}, tailPlaceNotFoundError
+	return cells
+}
+
+// Checks that the field has room for a new snake, the same way the snake is placed
+func (g *Game) CanSpawn() bool {
+	for _, cell := range g.field.allCells() {
+		if g.isSpawnCell(cell) {
+			return true
+		}
+	}
+	return false
 }
 
-func getAllEmptyCells(field [][]cellState) []Coord {
-	emptyCells := make([]Coord, 0)
-	for i := int32(0); i < int32(len(field)); i++ {
-		for j := int32(0); j < int32(len(field[i])); j++ {
-			if field[i][j] == cellState_EMPTY {
-				emptyCells = append(emptyCells, Coord{
-					x: j,
-					y: i,
-				})
+func isHeadCell(field *field, cell Coord) bool {
+	if !field.isEmpty(cell) {
+		return false
+	}
+
+	// In the walled field the 5*5 area centered at the cell must not cross the field edges
+	if field.walled && (cell.x < 2 || cell.x >= field.width-2 || cell.y < 2 || cell.y >= field.height-2) {
+		return false
+	}
+
+	// Checking that the 5*5 area centered at the cell does not contain other snakes and walls
+	for k := int32(-2); k <= 2; k++ {
+		for l := int32(-2); l <= 2; l++ {
+			state := field.get(field.neighbour(cell, l, k)).state
+			if state == cellState_SNAKE || state == cellState_WALL {
+				return false
 			}
 		}
 	}
 
-	return emptyCells
+	return true
+}
+
+// Offsets of the tail from the head of a new snake
+var availableTailCoords = []Coord{
+	{0, 1}, {1, 0}, {0, -1}, {-1, 0},
+}
+
+func hasTailCell(field *field, headCell Coord) bool {
+	for _, availableTailCoord := range availableTailCoords {
+		if field.isEmpty(field.neighbour(headCell, availableTailCoord.x, availableTailCoord.y)) {
+			return true
+		}
+	}
+	return false
+}
+
+func findTailCell(field *field, headCell Coord, random *rand.Rand) (Coord, error) {
+	// Check that the snake's tail will occupy a cell free of food and items (neighbour cells are checked in
+	// random order)
+	for _, idx := range random.Perm(len(availableTailCoords)) {
+		availableTailCoord := availableTailCoords[idx]
+		if field.isEmpty(field.neighbour(headCell, availableTailCoord.x, availableTailCoord.y)) {
+			return availableTailCoord, nil
+		}
+	}
+	return Coord{}, tailPlaceNotFoundError
 }
@@ -0,0 +1,236 @@
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestSpawn(t *testing.T) {
+	tests := []struct {
+		name          string
+		width         int32
+		height        int32
+		spawnDistance int32
+		snakes        map[int32]*Snake
+		wantSpawn     bool
+	}{
+		{
+			name:          "empty field",
+			width:         20,
+			height:        20,
+			spawnDistance: defaultSpawnDistance,
+			snakes:        map[int32]*Snake{},
+			wantSpawn:     true,
+		},
+		{
+			name:          "free squares only near the head",
+			width:         20,
+			height:        20,
+			spawnDistance: 20,
+			snakes:        map[int32]*Snake{1: NewSnake(1, []Coord{NewCoord(5, 5), NewCoord(-1, 0)}, false, RIGHT, 0)},
+			wantSpawn:     false,
+		},
+		{
+			name:          "free squares only in the way of the head",
+			width:         8,
+			height:        5,
+			spawnDistance: 2,
+			snakes:        map[int32]*Snake{1: NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-1, 0)}, false, RIGHT, 0)},
+			wantSpawn:     false,
+		},
+		{
+			name:          "free square away from the head",
+			width:         20,
+			height:        5,
+			spawnDistance: 2,
+			snakes:        map[int32]*Snake{1: NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(0, 1)}, false, UP, 0)},
+			wantSpawn:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGame("spawn", test.width, test.height, 0, 1)
+			g.SetSpawnDistance(test.spawnDistance)
+			g.SetSnakes(test.snakes)
+
+			if got := g.CanSpawn(); got != test.wantSpawn {
+				t.Errorf("CanSpawn() = %v, want %v", got, test.wantSpawn)
+			}
+			err := g.AddPlayer(10, "new", "", 0, true)
+			if (err == nil) != test.wantSpawn {
+				t.Errorf("AddPlayer() = %v, want spawned %v", err, test.wantSpawn)
+			}
+		})
+	}
+}
+
+// CanSpawn tells in advance whether AddPlayer places the snake, the heads never spawn next to other heads
+func TestSpawnUntilFull(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		g := NewGame("spawn", 30, 30, 0, seed)
+		for playerId := int32(1); ; playerId++ {
+			canSpawn := g.CanSpawn()
+			err := g.AddPlayer(playerId, "player", "", 0, true)
+			if canSpawn != (err == nil) {
+				t.Fatalf("seed %d, player %d: CanSpawn() = %v, AddPlayer() = %v", seed, playerId, canSpawn, err)
+			}
+			if err != nil {
+				break
+			}
+
+			head := g.Snakes[playerId].Points[0]
+			for otherId, other := range g.Snakes {
+				if otherId != playerId && g.Distance(head, other.Points[0]) <= g.SpawnDistance {
+					t.Fatalf("seed %d: head of %d at %v is next to the head of %d at %v", seed, playerId, head,
+						otherId, other.Points[0])
+				}
+			}
+		}
+	}
+}
+
+// Game on the 100x100 board with the snakes of length 81 moving right along their own rows
+func newBenchmarkGame(b *testing.B, snakeCount int32) *Game {
+	b.Helper()
+
+	g := NewGame("benchmark", 100, 100, 1, 1)
+	snakes := make(map[int32]*Snake)
+	for playerId := int32(1); playerId <= snakeCount; playerId++ {
+		if err := g.AddPlayer(playerId, fmt.Sprintf("player %d", playerId), "", 0, true); err != nil {
+			b.Fatal(err)
+		}
+		points := []Coord{NewCoord(80, 2*(playerId-1)), NewCoord(-80, 0)}
+		snakes[playerId] = NewSnake(playerId, points, false, RIGHT, 0)
+	}
+	g.SetSnakes(snakes)
+	return g
+}
+
+// The turn as it was computed before the occupancy grid: the snakes are moved, every head is compared with the
+// cells of every snake and the field for the new food is built from scratch
+func baselineNextState(g *Game) {
+	for _, playerId := range g.snakeIds() {
+		snake := g.Snakes[playerId]
+		snake.Move(snake.HeadDirection, g.Width, g.Height)
+		for idx, food := range g.Foods {
+			if food.Coord == snake.Points[0] {
+				g.Foods = append(g.Foods[:idx], g.Foods[idx+1:]...)
+				snake.Growth++
+				break
+			}
+		}
+	}
+
+	snakePoints := make(map[int32][]Coord)
+	for playerId, snake := range g.Snakes {
+		snakePoints[playerId] = snake.convertToPoints(g.Width, g.Height)
+	}
+	for _, playerId := range baselineDeaths(snakePoints) {
+		delete(g.Snakes, playerId)
+	}
+
+	field := baselineField(g)
+	for _, coord := range baselineFoods(field, g.FoodStatic+int32(len(g.Players))-int32(len(g.Foods)), g.random) {
+		g.Foods = append(g.Foods, NewFood(NORMAL_FOOD, coord))
+	}
+}
+
+func baselineDeaths(snakePoints map[int32][]Coord) []int32 {
+	deaths := make([]int32, 0)
+	for playerId, points := range snakePoints {
+		head := points[0]
+		if baselineContains(points[1:], head) {
+			deaths = append(deaths, playerId)
+			continue
+		}
+		for otherPlayerId, otherPoints := range snakePoints {
+			if otherPlayerId != playerId && baselineContains(otherPoints, head) {
+				deaths = append(deaths, playerId)
+				break
+			}
+		}
+	}
+	return deaths
+}
+
+func baselineContains(points []Coord, searchPoint Coord) bool {
+	for _, point := range points {
+		if point == searchPoint {
+			return true
+		}
+	}
+	return false
+}
+
+func baselineField(g *Game) [][]cellState {
+	field := make([][]cellState, g.Height)
+	for y := int32(0); y < g.Height; y++ {
+		field[y] = make([]cellState, g.Width)
+	}
+	for _, snake := range g.Snakes {
+		for _, point := range snake.convertToPoints(g.Width, g.Height) {
+			field[point.y][point.x] = cellState_SNAKE
+		}
+	}
+	for _, food := range g.Foods {
+		field[food.Coord.y][food.Coord.x] = cellState_FOOD
+	}
+	return field
+}
+
+func baselineFoods(field [][]cellState, count int32, random *rand.Rand) []Coord {
+	if count <= 0 {
+		return []Coord{}
+	}
+	emptyCells := make([]Coord, 0)
+	for y := range field {
+		for x := range field[y] {
+			if field[y][x] == cellState_EMPTY {
+				emptyCells = append(emptyCells, NewCoord(int32(x), int32(y)))
+			}
+		}
+	}
+	if int32(len(emptyCells)) <= count {
+		return emptyCells
+	}
+	foods := make([]Coord, count)
+	for k := int32(0); k < count; k++ {
+		idx := random.Intn(len(emptyCells))
+		foods[k] = emptyCells[idx]
+		emptyCells = append(emptyCells[:idx], emptyCells[idx+1:]...)
+	}
+	return foods
+}
+
+func benchmarkNextState(b *testing.B, nextState func(g *Game)) {
+	for _, snakeCount := range []int32{10, 50} {
+		b.Run(fmt.Sprintf("%d snakes", snakeCount), func(b *testing.B) {
+			initial := newBenchmarkGame(b, snakeCount)
+			g := initial.Clone()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// The snakes grow on the food, so the game starts over before they can crash
+				if i%64 == 0 {
+					b.StopTimer()
+					g = initial.Clone()
+					b.StartTimer()
+				}
+				nextState(g)
+			}
+		})
+	}
+}
+
+// The occupancy grid is updated as the snakes move
+func BenchmarkNextStateIncremental(b *testing.B) {
+	benchmarkNextState(b, func(g *Game) {
+		g.NextState(map[int32]Direction{})
+	})
+}
+
+func BenchmarkNextStateBaseline(b *testing.B) {
+	benchmarkNextState(b, baselineNextState)
+}
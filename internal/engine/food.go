@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"math/rand"
+)
+
+type FoodKind int
+
+const (
+	NORMAL_FOOD FoodKind = 1
+	GOLDEN_FOOD FoodKind = 2
+	POISON_FOOD FoodKind = 3
+)
+
+// Properties of the food kind
+type FoodSpec struct {
+	Kind   FoodKind
+	Points int32 // Points the player gets for eating the food
+	Growth int32 // Number of cells the snake grows by (negative if the snake shrinks)
+}
+
+// Food kinds that can be spawned on the field, the chance of each kind is set by Game.FoodWeights
+var FoodSpecs = []FoodSpec{
+	{Kind: NORMAL_FOOD, Points: 1, Growth: 1},
+	{Kind: GOLDEN_FOOD, Points: 3, Growth: 3},
+	{Kind: POISON_FOOD, Points: 0, Growth: -2},
+}
+
+type Food struct {
+	Kind  FoodKind
+	Coord Coord
+}
+
+func NewFood(kind FoodKind, coord Coord) Food {
+	return Food{
+		Kind:  kind,
+		Coord: coord,
+	}
+}
+
+func foodSpec(kind FoodKind) FoodSpec {
+	for _, spec := range FoodSpecs {
+		if spec.Kind == kind {
+			return spec
+		}
+	}
+	return FoodSpecs[0]
+}
+
+// Chooses the kind of new food according to the weights (normal food if no kind has a weight)
+func randomFoodKind(weights map[FoodKind]int32, random *rand.Rand) FoodKind {
+	totalWeight := int32(0)
+	for _, spec := range FoodSpecs {
+		totalWeight += weights[spec.Kind]
+	}
+	if totalWeight <= 0 {
+		return NORMAL_FOOD
+	}
+
+	weight := random.Int31n(totalWeight)
+	for _, spec := range FoodSpecs {
+		if weight < weights[spec.Kind] {
+			return spec.Kind
+		}
+		weight -= weights[spec.Kind]
+	}
+	return NORMAL_FOOD
+}
+
+func (g *Game) SetFoodWeights(weights map[FoodKind]int32) {
+	g.FoodWeights = weights
+}
+
+func (g *Game) eatFood(playerId int32, coord Coord) {
+	kind := g.deleteFood(coord)
+	spec := foodSpec(kind)
+	snake := g.Snakes[playerId]
+	g.events = append(g.events, NewFoodEatenEvent(playerId, coord))
+
+	if spec.Growth > 0 {
+		snake.Growth += spec.Growth
+	} else if spec.Growth < 0 {
+		g.shrinkSnake(playerId, -spec.Growth)
+	}
+
+	if !snake.IsZombie && spec.Points > 0 {
+		g.awardPoints(playerId, coord, spec.Points)
+	}
+}
+
+func (g *Game) shrinkSnake(playerId int32, count int32) {
+	for _, point := range g.Snakes[playerId].shrink(count, g.Width, g.Height) {
+		if cell := g.field.get(point); cell.state == cellState_SNAKE && cell.owner == playerId {
+			g.field.setEmpty(point)
+		}
+	}
+}
@@ -1,138 +1,510 @@
 package engine
 
+import (
+	"math/rand"
+	"sort"
+
+	"p2p-snake/internal/log"
+)
+
 type Game struct {
 	// Config
 	Name       string
 	Width      int32
 	Height     int32
 	FoodStatic int32
+	Seed       int64
+	Walled     bool    // The field edges are walls, otherwise the field is a torus
+	Walls      []Coord // Interior wall cells
+	MaxItems   int32   // Maximum number of items on the field (0 disables items)
+
+	// Relative chances of the food kinds to be spawned
+	FoodWeights map[FoodKind]int32
+
+	// Map
+	SpawnPoints []Coord // Preferred cells for the heads of new snakes
+	FoodSpots   []Coord // Cells where food appears whenever they are empty
+
+	// New snakes avoid the cells within this distance of the heads of other snakes and twice as far ahead of them
+	SpawnDistance int32
+
+	// Number of turns during which a player who left can reclaim their zombie snake (0 disables it)
+	ReclaimTurns int32
+
+	// Number of turns after which the player whose snake died gets a new snake (0 disables respawn)
+	RespawnTurns        int32
+	HalveScoreOnRespawn bool
+
+	// Teams (0 teams disables them), teammates may pass through each other's bodies
+	TeamCount     int32
+	SafeTeammates bool
+
+	// Number of turns after which the next border ring of the field becomes walls (0 disables shrinking)
+	ShrinkInterval int32
+
+	// End conditions (0 or false disables the condition)
+	MaxTurns          int32
+	TargetScore       int32
+	LastSnakeStanding bool
+
+	// Result
+	Finish   FinishReason
+	WinnerId int32 // 0 if nobody won
+
+	// Rounds
+	Round          int32
+	RoundStartTurn int32 // Turn when the current round started
+
+	// Shrinking arena
+	ArenaLevel     int32 // Number of border rings that have become walls
+	NextShrinkTurn int32 // Turn when the next ring becomes walls (0 if the arena does not shrink anymore)
+
+	// Random
+	random *rand.Rand
 
 	// State
+	Turn    int32
 	Snakes  map[int32]*Snake
 	Players map[int32]*Player
-	Foods   []Coord
+	Foods   []Food
+	Items   []Item
+
+	// Points scored by the players of every team
+	TeamScores map[int32]int32
+
+	// Occupancy grid
+	field *field
+
+	// Events that happened since the last turn
+	events []Event
 }
 
-func NewGame(gameName string, width int32, height int32, foodStatic int32) *Game {
+func NewGame(gameName string, width int32, height int32, foodStatic int32, seed int64) *Game {
+	return NewGameWithSource(gameName, width, height, foodStatic, seed, rand.NewSource(seed))
+}
+
+func NewGameWithSource(gameName string, width int32, height int32, foodStatic int32, seed int64, source rand.Source) *Game {
 	return &Game{
 		Name:       gameName,
 		Width:      width,
 		Height:     height,
 		FoodStatic: foodStatic,
+		Seed:       seed,
+
+		FoodWeights: map[FoodKind]int32{NORMAL_FOOD: 1},
+
+		random: rand.New(source),
+
+		Round: 1,
 
 		Snakes:  make(map[int32]*Snake),
 		Players: make(map[int32]*Player),
-		Foods:   make([]Coord, 0),
+		Foods:   make([]Food, 0),
+		Items:   make([]Item, 0),
+		Walls:   make([]Coord, 0),
+
+		TeamScores: make(map[int32]int32),
+
+		SpawnPoints: make([]Coord, 0),
+		FoodSpots:   make([]Coord, 0),
+
+		SpawnDistance: defaultSpawnDistance,
+
+		field: newField(width, height, false),
+
+		events: make([]Event, 0),
 	}
 }
 
-func (g *Game) AddPlayer(playerId int32, playerName string, withSnake bool) error {
+func (g *Game) SetSize(width int32, height int32) {
+	g.Width = width
+	g.Height = height
+	g.rebuildField()
+}
+
+func (g *Game) SetWalls(walled bool, walls []Coord) {
+	g.Walled = walled
+	g.Walls = walls
+	g.rebuildField()
+}
+
+func (g *Game) SetSpawnPoints(spawnPoints []Coord) {
+	g.SpawnPoints = spawnPoints
+}
+
+func (g *Game) SetFoodSpots(foodSpots []Coord) {
+	g.FoodSpots = foodSpots
+}
+
+func (g *Game) SetSpawnDistance(spawnDistance int32) {
+	g.SpawnDistance = spawnDistance
+}
+
+func (g *Game) SetSnakes(snakes map[int32]*Snake) {
+	g.Snakes = snakes
+	g.rebuildField()
+}
+
+func (g *Game) SetFoods(foods []Food) {
+	g.Foods = foods
+	g.rebuildField()
+}
+
+// Adds the player to the team (if it is 0, the team is chosen automatically), spectators are not in teams
+func (g *Game) AddPlayer(playerId int32, playerName string, tokenHash string, team int32, withSnake bool) error {
 	// Create player
-	g.Players[playerId] = NewPlayer(playerId, playerName, 0)
+	player := NewPlayer(playerId, playerName, 0, tokenHash, !withSnake)
+	if withSnake {
+		player.Team = g.chooseTeam(team)
+	}
+	g.Players[playerId] = player
 
+	// The player without room for the snake is not added
 	if withSnake {
-		// Create snake
-		field := g.createField()
-		snakeCoords, err := createSnake(field)
-		if err != nil {
+		if err := g.addSnake(playerId); err != nil {
+			delete(g.Players, playerId)
 			return err
 		}
+	}
 
-		var headDirection Direction
-		switch {
-		case snakeCoords[1].y > 0:
-			headDirection = UP
-		case snakeCoords[0].y < 0:
-			headDirection = DOWN
-		case snakeCoords[0].x > 0:
-			headDirection = LEFT
-		case snakeCoords[0].x < 0:
-			headDirection = RIGHT
-		}
+	return nil
+}
+
+func (g *Game) addSnake(playerId int32) error {
+	snakeCoords, err := g.createSnake()
+	if err != nil {
+		return err
+	}
 
-		g.Snakes[playerId] = NewSnake(playerId, snakeCoords, false, headDirection, false)
+	// The head direction is opposite to the tail direction. snakeCoords[0] is the absolute head cell and
+	// snakeCoords[1] is the tail offset from it, so only the offset tells the direction
+	var headDirection Direction
+	switch {
+	case snakeCoords[1].y > 0:
+		headDirection = UP
+	case snakeCoords[1].y < 0:
+		headDirection = DOWN
+	case snakeCoords[1].x > 0:
+		headDirection = LEFT
+	case snakeCoords[1].x < 0:
+		headDirection = RIGHT
 	}
 
+	snake := NewSnake(playerId, snakeCoords, false, headDirection, 0)
+	for _, point := range snake.convertToPoints(g.Width, g.Height) {
+		g.field.setSnake(point, playerId)
+	}
+	g.Snakes[playerId] = snake
+
 	return nil
 }
 
+// Starts a new round: all snakes and food are removed and every player except spectators gets a new snake
+func (g *Game) RestartRound(keepScores bool) {
+	g.Round++
+	g.RoundStartTurn = g.Turn
+	g.Finish = NOT_FINISHED
+	g.WinnerId = 0
+
+	g.Snakes = make(map[int32]*Snake)
+	g.Foods = make([]Food, 0)
+	g.Items = make([]Item, 0)
+	g.ArenaLevel = 0
+	if !keepScores {
+		g.TeamScores = make(map[int32]int32)
+	}
+	g.SetShrinkInterval(g.ShrinkInterval)
+	g.rebuildField()
+
+	for _, playerId := range g.playerIds() {
+		player := g.Players[playerId]
+		player.RespawnTurn = 0
+		if !keepScores {
+			player.Score = 0
+			player.Stats = NewPlayerStats()
+		}
+		if player.Spectator {
+			continue
+		}
+		if err := g.addSnake(playerId); err != nil {
+			log.Logger.Errorf("player %d has not got a snake in round %d: %v", playerId, g.Round, err)
+		}
+	}
+}
+
 func (g *Game) DeletePlayer(playerId int32) {
+	player, playerOk := g.Players[playerId]
 	delete(g.Players, playerId)
-	g.Snakes[playerId].IsZombie = true
+
+	if snake, ok := g.Snakes[playerId]; ok && !snake.IsZombie {
+		snake.IsZombie = true
+		if playerOk && g.ReclaimTurns > 0 && player.TokenHash != "" {
+			snake.Owner = NewZombieOwner(player.Name, player.Score, player.TokenHash, g.Turn+g.ReclaimTurns, player.Team,
+				player.Stats)
+		}
+		g.events = append(g.events, NewZombieCreatedEvent(playerId, snake.Points[0]))
+	}
 }
 
-func (g *Game) addFood(field [][]cellState) {
-	newFoodCoords := createFoods(field, g.FoodStatic+int32(len(g.Players))-int32(len(g.Foods)))
-	g.Foods = append(g.Foods, newFoodCoords...)
+// Removes the player together with the snake, which does not stay on the field as a zombie
+func (g *Game) RemovePlayer(playerId int32) {
+	delete(g.Players, playerId)
+	if _, ok := g.Snakes[playerId]; ok {
+		delete(g.Snakes, playerId)
+		g.rebuildField()
+	}
 }
 
-func (g *Game) NextState(directionChanges map[int32]Direction) []int32 {
+func (g *Game) ReclaimSnake(playerName string, tokenHash string) (int32, bool) {
 	for playerId, snake := range g.Snakes {
-		if snake.IsZombie {
+		owner := snake.Owner
+		if owner == nil || owner.Name != playerName || owner.TokenHash != tokenHash || owner.Deadline < g.Turn {
 			continue
 		}
 
-		// Move the snake 1 cell
-		if newDirection, ok := directionChanges[playerId]; ok {
-			snake.Move(newDirection, g.Width, g.Height)
-		} else {
-			snake.Move(snake.HeadDirection, g.Width, g.Height)
+		// Give the zombie snake back to the player
+		player := NewPlayer(playerId, owner.Name, owner.Score, owner.TokenHash, false)
+		player.Team = owner.Team
+		if owner.Stats != nil {
+			player.Stats = owner.Stats
 		}
+		g.Players[playerId] = player
+		snake.IsZombie = false
+		snake.Owner = nil
+		return playerId, true
+	}
+	return 0, false
+}
 
-		// Check if the snake has eaten the food
-		for idx, foodCoord := range g.Foods {
-			headCoord := snake.Points[0]
-			if foodCoord.x == headCoord.x && foodCoord.y == headCoord.y {
-				g.Foods = append(g.Foods[:idx], g.Foods[idx+1:]...)
-				snake.IsEating = true
-				if player, ok := g.Players[playerId]; ok {
-					player.Score++
-				}
-				break
-			}
+func (g *Game) addFood() {
+	// Food spots of the map are refilled regardless of the amount of food
+	spotFoodCoords := make([]Coord, 0)
+	for _, spot := range g.FoodSpots {
+		if g.field.isEmpty(spot) {
+			g.field.setFood(spot)
+			spotFoodCoords = append(spotFoodCoords, spot)
 		}
 	}
+	g.spawnFoods(spotFoodCoords, true)
 
-	// Check all pairs of snake for collision
-	snakePoints := make(map[int32][]Coord)
-	for playerId, snake := range g.Snakes {
-		snakePoints[playerId] = snake.convertToPoints(g.Width, g.Height)
+	newFoodCoords := createFoods(g.field, g.FoodStatic+int32(len(g.Players))-int32(len(g.Foods)), g.random)
+	g.spawnFoods(newFoodCoords, true)
+}
+
+// Adds the food to the cells, the kinds are chosen by the food weights or the food is normal
+func (g *Game) spawnFoods(coords []Coord, mixed bool) {
+	for _, coord := range coords {
+		kind := NORMAL_FOOD
+		if mixed {
+			kind = randomFoodKind(g.FoodWeights, g.random)
+		}
+		g.Foods = append(g.Foods, NewFood(kind, coord))
+		g.events = append(g.events, NewFoodSpawnedEvent(coord))
 	}
+}
+
+func (g *Game) awardPoints(playerId int32, coord Coord, points int32) {
+	if snake, ok := g.Snakes[playerId]; ok && snake.HasEffect(SCORE_MULTIPLIER) {
+		points *= scoreMultiplier
+	}
+	if player, ok := g.Players[playerId]; ok {
+		player.Score += points
+		g.addTeamPoints(playerId, points)
+		g.events = append(g.events, NewPointsAwardedEvent(playerId, coord, points))
+	}
+}
+
+func (g *Game) deleteFood(coord Coord) FoodKind {
+	kind := NORMAL_FOOD
+	for idx, food := range g.Foods {
+		if food.Coord == coord {
+			kind = food.Kind
+			g.Foods = append(g.Foods[:idx], g.Foods[idx+1:]...)
+			break
+		}
+	}
+	g.field.setEmpty(coord)
+	return kind
+}
+
+func (g *Game) NextState(directionChanges map[int32]Direction) []Event {
+	g.Turn++
+
+	// Nothing happens in the finished game
+	if g.Finish != NOT_FINISHED {
+		events := g.events
+		g.events = make([]Event, 0)
+		return events
+	}
+	aliveCount := g.aliveSnakeCount()
+
+	// Snakes are processed in a fixed order to keep the game reproducible for the same seed
+	snakeIds := g.snakeIds()
+
+	// Zombie snakes can not be reclaimed after the deadline
+	for _, playerId := range snakeIds {
+		if owner := g.Snakes[playerId].Owner; owner != nil && owner.Deadline < g.Turn {
+			g.Snakes[playerId].Owner = nil
+		}
+	}
+
+	// Effects of the items picked up earlier may be over
+	g.expireEffects()
+
+	// Move all snakes, then move the boosted snakes once again
+	deadSnakePoints := make(map[int32][]Coord)
+	deaths := g.moveSnakes(snakeIds, directionChanges, deadSnakePoints)
+	if boostedIds := g.boostedSnakeIds(snakeIds); len(boostedIds) > 0 {
+		deaths = append(deaths, g.moveSnakes(boostedIds, map[int32]Direction{}, deadSnakePoints)...)
+	}
+	deaths = append(deaths, g.shrinkArena(deadSnakePoints)...)
+
+	// Turn dead snakes into food
+	for _, death := range deaths {
+		g.spawnFoods(createFoodsFromSnake(g.field, deadSnakePoints[death.PlayerId], g.random), false)
+	}
+
+	g.scheduleRespawns(deaths)
+	g.respawnSnakes()
+	g.addFood()
+	g.addItem()
+
+	g.checkFinish(aliveCount)
+	g.updateStats(g.events)
+
+	events := g.events
+	g.events = make([]Event, 0)
+	return events
+}
 
-	deadSnakes := make(map[int32]bool)
-	for playerId1, snakePoints1 := range snakePoints {
-		for playerId2, snakePoints2 := range snakePoints {
-			if playerId1 == playerId2 {
-				if containsPoint(snakePoints1[1:], snakePoints1[0]) {
-					deadSnakes[playerId1] = true
-				}
-				continue
+// Moves the snakes 1 cell, returns the deaths and saves the cells of the dead snakes
+func (g *Game) moveSnakes(snakeIds []int32, directionChanges map[int32]Direction, deadSnakePoints map[int32][]Coord) []Event {
+	// Snakes that crashed into the field edges with the cells they crashed at
+	borderCrashes := make(map[int32]Coord)
+
+	for _, playerId := range snakeIds {
+		snake := g.Snakes[playerId]
+		tail := snake.tail(g.Width, g.Height)
+		isGrowing := snake.Growth > 0
+
+		// Move the snake 1 cell (zombie snake keeps moving in its last direction)
+		direction := snake.HeadDirection
+		if newDirection, ok := directionChanges[playerId]; ok && !snake.IsZombie {
+			direction = newDirection
+			if snake.moveDirection(direction) != snake.HeadDirection {
+				g.countTurn(playerId)
 			}
-			if containsPoint(snakePoints1, snakePoints2[0]) {
-				deadSnakes[playerId2] = true
+		}
+		if head := snake.Points[0]; g.Walled && g.leavesField(head, snake.moveDirection(direction)) {
+			borderCrashes[playerId] = head
+		}
+		snake.Move(direction, g.Width, g.Height)
+
+		// Free the cell left by the tail
+		if cell := g.field.get(tail); !isGrowing && cell.state == cellState_SNAKE && cell.owner == playerId {
+			g.field.setEmpty(tail)
+		}
+	}
+
+	// Check if the snakes have eaten the food or picked up the items
+	for _, playerId := range snakeIds {
+		snake := g.Snakes[playerId]
+		if _, ok := borderCrashes[playerId]; ok {
+			continue
+		}
+		head := snake.Points[0]
+		switch g.field.get(head).state {
+		case cellState_FOOD:
+			g.eatFood(playerId, head)
+		case cellState_ITEM:
+			g.pickUpItem(playerId, head)
+		}
+	}
+
+	// Check all snakes for collision
+	deaths := g.findDeaths(snakeIds, borderCrashes)
+
+	g.events = append(g.events, deaths...)
+
+	// Reward the snakes that were hit (zombie snakes have no player to reward)
+	for _, death := range deaths {
+		for _, killerId := range death.KillerIds {
+			if !g.Snakes[killerId].IsZombie {
+				g.awardPoints(killerId, death.Coord, 1)
 			}
-			if containsPoint(snakePoints2, snakePoints1[0]) {
-				deadSnakes[playerId1] = true
+		}
+	}
+
+	// Free the cells of dead snakes
+	for _, death := range deaths {
+		points := g.Snakes[death.PlayerId].convertToPoints(g.Width, g.Height)
+		if _, ok := borderCrashes[death.PlayerId]; ok {
+			// The head of the snake crashed into the field edge is outside the field
+			points = points[1:]
+		}
+		for _, point := range points {
+			if cell := g.field.get(point); cell.state == cellState_SNAKE && cell.owner == death.PlayerId {
+				g.field.setEmpty(point)
 			}
 		}
+		deadSnakePoints[death.PlayerId] = points
+		delete(g.Snakes, death.PlayerId)
 	}
-	deadPlayerIds := make([]int32, 0)
-	for playerId := range deadSnakes {
-		delete(g.Snakes, playerId)
-		deadPlayerIds = append(deadPlayerIds, playerId)
+
+	if g.hasGhosts() || g.field.overlaps || g.headsOnSnakes(snakeIds) {
+		// Ghost snakes and teammates passing through each other may share cells with other snakes, so the cells
+		// are recalculated from scratch
+		g.rebuildField()
+		return deaths
 	}
 
-	field := g.createField()
-	g.addFood(field)
+	// Occupy the cells with heads of alive snakes
+	for _, playerId := range snakeIds {
+		if snake, ok := g.Snakes[playerId]; ok {
+			g.field.setSnake(snake.Points[0], playerId)
+		}
+	}
 
-	return deadPlayerIds
+	return deaths
 }
 
-func containsPoint(points []Coord, searchPoint Coord) bool {
-	for _, point := range points {
-		if searchPoint.x == point.x && searchPoint.y == point.y {
+func (g *Game) headsOnSnakes(snakeIds []int32) bool {
+	for _, playerId := range snakeIds {
+		if snake, ok := g.Snakes[playerId]; ok && g.field.get(snake.Points[0]).state == cellState_SNAKE {
 			return true
 		}
 	}
 	return false
 }
+
+func (g *Game) leavesField(head Coord, direction Direction) bool {
+	switch direction {
+	case UP:
+		return head.y == 0
+	case DOWN:
+		return head.y == g.Height-1
+	case LEFT:
+		return head.x == 0
+	case RIGHT:
+		return head.x == g.Width-1
+	}
+	return false
+}
+
+func (g *Game) playerIds() []int32 {
+	playerIds := make([]int32, 0, len(g.Players))
+	for playerId := range g.Players {
+		playerIds = append(playerIds, playerId)
+	}
+	sort.Slice(playerIds, func(i, j int) bool { return playerIds[i] < playerIds[j] })
+	return playerIds
+}
+
+func (g *Game) snakeIds() []int32 {
+	snakeIds := make([]int32, 0, len(g.Snakes))
+	for playerId := range g.Snakes {
+		snakeIds = append(snakeIds, playerId)
+	}
+	sort.Slice(snakeIds, func(i, j int) bool { return snakeIds[i] < snakeIds[j] })
+	return snakeIds
+}
@@ -0,0 +1,419 @@
+package engine
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// Random source whose every roll is 0, so that every free cell of a dead snake becomes food
+type zeroSource struct{}
+
+func (zeroSource) Int63() int64 { return 0 }
+func (zeroSource) Seed(int64)   {}
+
+type death struct {
+	playerId  int32
+	cause     DeathCause
+	killerIds []int32
+}
+
+// Turns of the 10x10 game without static food, the owners of the snakes are players unless they are zombies
+type turnTest struct {
+	name        string
+	snakes      []*Snake
+	zombies     []int32
+	foods       []Food
+	directions  map[int32]Direction // Direction changes sent every turn
+	turns       int
+	wantHeads   map[int32]Coord
+	wantLengths map[int32]int32
+	wantDeaths  []death
+	wantScores  map[int32]int32
+	wantFoods   []Coord // Not checked if nil
+}
+
+func runTurnTests(t *testing.T, tests []turnTest) {
+	t.Helper()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGameWithSource("rules", 10, 10, 0, 0, zeroSource{})
+			snakes := make(map[int32]*Snake)
+			for _, snake := range test.snakes {
+				snakes[snake.PlayerId] = snake
+				g.Players[snake.PlayerId] = NewPlayer(snake.PlayerId, "player", 0, "", false)
+			}
+			g.SetSnakes(snakes)
+			g.SetFoods(test.foods)
+			for _, playerId := range test.zombies {
+				g.DeletePlayer(playerId)
+			}
+
+			deaths := make([]death, 0)
+			for turn := 0; turn < test.turns; turn++ {
+				for _, event := range g.NextState(test.directions) {
+					if event.Type == SNAKE_DIED {
+						deaths = append(deaths, death{event.PlayerId, event.Cause, event.KillerIds})
+					}
+				}
+			}
+
+			for playerId, want := range test.wantHeads {
+				if snake, ok := g.Snakes[playerId]; !ok || snake.Points[0] != want {
+					t.Errorf("head of %d = %v, want %v", playerId, g.Snakes[playerId], want)
+				}
+			}
+			for playerId, want := range test.wantLengths {
+				if snake, ok := g.Snakes[playerId]; !ok || snake.length() != want {
+					t.Errorf("snake %d = %v, want length %d", playerId, g.Snakes[playerId], want)
+				}
+			}
+			if !reflect.DeepEqual(deaths, test.wantDeaths) {
+				t.Errorf("deaths = %v, want %v", deaths, test.wantDeaths)
+			}
+			scores := make(map[int32]int32)
+			for playerId, player := range g.Players {
+				scores[playerId] = player.Score
+			}
+			if !reflect.DeepEqual(scores, test.wantScores) {
+				t.Errorf("scores = %v, want %v", scores, test.wantScores)
+			}
+			if foods := sortCoords(g.FoodCells()); test.wantFoods != nil &&
+				!reflect.DeepEqual(foods, sortCoords(test.wantFoods)) {
+				t.Errorf("foods = %v, want %v", foods, sortCoords(test.wantFoods))
+			}
+		})
+	}
+}
+
+func sortCoords(coords []Coord) []Coord {
+	sort.Slice(coords, func(i, j int) bool {
+		if coords[i].y != coords[j].y {
+			return coords[i].y < coords[j].y
+		}
+		return coords[i].x < coords[j].x
+	})
+	return coords
+}
+
+func TestSnakeDeaths(t *testing.T) {
+	runTurnTests(t, []turnTest{
+		{
+			name: "snake hits the body of other snake",
+			snakes: []*Snake{
+				NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-2, 0)}, false, RIGHT, 0),
+				NewSnake(2, []Coord{NewCoord(3, 4), NewCoord(0, -3)}, false, DOWN, 0),
+			},
+			turns:      1,
+			wantDeaths: []death{{1, SNAKE, []int32{2}}},
+			wantScores: map[int32]int32{1: 0, 2: 1},
+			// The crash cell stays under the body of the other snake
+			wantFoods: []Coord{NewCoord(1, 2), NewCoord(2, 2)},
+		},
+		{
+			name: "head-on collision kills both snakes",
+			snakes: []*Snake{
+				NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-2, 0)}, false, RIGHT, 0),
+				NewSnake(2, []Coord{NewCoord(4, 2), NewCoord(2, 0)}, false, LEFT, 0),
+			},
+			turns:      1,
+			wantDeaths: []death{{1, HEAD_ON, []int32{2}}, {2, HEAD_ON, []int32{1}}},
+			wantScores: map[int32]int32{1: 1, 2: 1},
+			wantFoods:  []Coord{NewCoord(1, 2), NewCoord(2, 2), NewCoord(3, 2), NewCoord(4, 2), NewCoord(5, 2)},
+		},
+		{
+			name: "all heads on one cell die, every snake gets a point for each killed one",
+			snakes: []*Snake{
+				NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-2, 0)}, false, RIGHT, 0),
+				NewSnake(2, []Coord{NewCoord(4, 2), NewCoord(2, 0)}, false, LEFT, 0),
+				NewSnake(3, []Coord{NewCoord(3, 3), NewCoord(0, 1)}, false, UP, 0),
+			},
+			turns: 1,
+			wantDeaths: []death{
+				{1, HEAD_ON, []int32{2, 3}},
+				{2, HEAD_ON, []int32{1, 3}},
+				{3, HEAD_ON, []int32{1, 2}},
+			},
+			wantScores: map[int32]int32{1: 2, 2: 2, 3: 2},
+			wantFoods: []Coord{NewCoord(1, 2), NewCoord(2, 2), NewCoord(3, 2), NewCoord(4, 2), NewCoord(5, 2),
+				NewCoord(3, 3)},
+		},
+		{
+			name: "snake hits itself without giving points",
+			snakes: []*Snake{
+				NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(1, 0), NewCoord(0, 1), NewCoord(-2, 0)}, false, LEFT, 0),
+			},
+			directions: map[int32]Direction{1: DOWN},
+			turns:      1,
+			wantDeaths: []death{{1, SELF, []int32{}}},
+			wantScores: map[int32]int32{1: 0},
+			wantFoods:  []Coord{NewCoord(2, 2), NewCoord(3, 2), NewCoord(2, 3), NewCoord(3, 3)},
+		},
+	})
+}
+
+func TestZombieSnakes(t *testing.T) {
+	runTurnTests(t, []turnTest{
+		{
+			name:       "zombie keeps moving in its last direction",
+			snakes:     []*Snake{NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-1, 0)}, false, RIGHT, 0)},
+			zombies:    []int32{1},
+			directions: map[int32]Direction{1: UP},
+			turns:      2,
+			wantHeads:  map[int32]Coord{1: NewCoord(4, 2)},
+			wantDeaths: []death{},
+			wantScores: map[int32]int32{},
+		},
+		{
+			name:        "zombie eats food without scoring",
+			snakes:      []*Snake{NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-1, 0)}, false, RIGHT, 0)},
+			zombies:     []int32{1},
+			foods:       []Food{NewFood(NORMAL_FOOD, NewCoord(3, 2))},
+			turns:       2,
+			wantLengths: map[int32]int32{1: 3},
+			wantDeaths:  []death{},
+			wantScores:  map[int32]int32{},
+			wantFoods:   []Coord{},
+		},
+		{
+			name: "snake hitting a zombie gives nobody points",
+			snakes: []*Snake{
+				NewSnake(1, []Coord{NewCoord(3, 4), NewCoord(0, -3)}, false, DOWN, 0),
+				NewSnake(2, []Coord{NewCoord(2, 2), NewCoord(-1, 0)}, false, RIGHT, 0),
+			},
+			zombies:    []int32{1},
+			turns:      1,
+			wantDeaths: []death{{2, SNAKE, []int32{1}}},
+			wantScores: map[int32]int32{2: 0},
+			wantFoods:  []Coord{NewCoord(2, 2)},
+		},
+		{
+			name: "zombie crashing into a snake gives the snake a point",
+			snakes: []*Snake{
+				NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-1, 0)}, false, RIGHT, 0),
+				NewSnake(2, []Coord{NewCoord(3, 4), NewCoord(0, -3)}, false, DOWN, 0),
+			},
+			zombies:    []int32{1},
+			turns:      1,
+			wantDeaths: []death{{1, SNAKE, []int32{2}}},
+			wantScores: map[int32]int32{2: 1},
+			wantFoods:  []Coord{NewCoord(2, 2)},
+		},
+	})
+}
+
+// A player without a snake leaves the game without a zombie
+func TestDeletePlayerWithoutSnake(t *testing.T) {
+	g := NewGame("rules", 10, 10, 0, 1)
+	if err := g.AddPlayer(1, "viewer", "", 0, false); err != nil {
+		t.Fatal(err)
+	}
+
+	g.DeletePlayer(1)
+	if _, ok := g.Players[1]; ok || len(g.Snakes) != 0 {
+		t.Errorf("players = %v, snakes = %v, want none", g.Players, g.Snakes)
+	}
+}
+
+func TestSeedReproducesGame(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     int64
+		other    int64
+		wantSame bool
+	}{
+		{name: "same seed gives the same game", seed: 7, other: 7, wantSame: true},
+		{name: "other seed gives other game", seed: 7, other: 8, wantSame: false},
+	}
+
+	// Snakes, food and the dead snake rolls depend on the seed only
+	play := func(t *testing.T, seed int64) ([]gameView, [][]Event) {
+		g := NewGame("seed", 20, 20, 5, seed)
+		g.SetFoodWeights(map[FoodKind]int32{NORMAL_FOOD: 3, GOLDEN_FOOD: 1, POISON_FOOD: 1})
+		for playerId := int32(1); playerId <= 3; playerId++ {
+			if err := g.AddPlayer(playerId, "player", "", 0, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+		directions := []Direction{UP, LEFT, DOWN, RIGHT}
+		views := make([]gameView, 0)
+		events := make([][]Event, 0)
+		for turn := 0; turn < 100; turn++ {
+			events = append(events, g.NextState(map[int32]Direction{int32(turn%3 + 1): directions[turn/5%4]}))
+			views = append(views, viewGame(g))
+		}
+		return views, events
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			views, events := play(t, test.seed)
+			otherViews, otherEvents := play(t, test.other)
+			same := reflect.DeepEqual(views, otherViews) && reflect.DeepEqual(events, otherEvents)
+			if same != test.wantSame {
+				t.Errorf("games with seeds %d and %d are the same = %v, want %v", test.seed, test.other, same,
+					test.wantSame)
+			}
+		})
+	}
+}
+
+func TestFoodKinds(t *testing.T) {
+	runTurnTests(t, []turnTest{
+		{
+			name:        "normal food grows the snake by 1 cell",
+			snakes:      []*Snake{NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-1, 0)}, false, RIGHT, 0)},
+			foods:       []Food{NewFood(NORMAL_FOOD, NewCoord(3, 2))},
+			turns:       2,
+			wantLengths: map[int32]int32{1: 3},
+			wantDeaths:  []death{},
+			wantScores:  map[int32]int32{1: 1},
+		},
+		{
+			name:        "golden food grows the snake by 3 cells",
+			snakes:      []*Snake{NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-1, 0)}, false, RIGHT, 0)},
+			foods:       []Food{NewFood(GOLDEN_FOOD, NewCoord(3, 2))},
+			turns:       4,
+			wantLengths: map[int32]int32{1: 5},
+			wantDeaths:  []death{},
+			wantScores:  map[int32]int32{1: 3},
+		},
+		{
+			name:        "poison shrinks the snake by 2 cells",
+			snakes:      []*Snake{NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-3, 0)}, false, RIGHT, 0)},
+			foods:       []Food{NewFood(POISON_FOOD, NewCoord(3, 2))},
+			turns:       1,
+			wantLengths: map[int32]int32{1: 2},
+			wantDeaths:  []death{},
+			wantScores:  map[int32]int32{1: 0},
+		},
+		{
+			name:        "poison does not shrink the snake below 2 cells",
+			snakes:      []*Snake{NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-2, 0)}, false, RIGHT, 0)},
+			foods:       []Food{NewFood(POISON_FOOD, NewCoord(3, 2))},
+			turns:       1,
+			wantLengths: map[int32]int32{1: 2},
+			wantDeaths:  []death{},
+			wantScores:  map[int32]int32{1: 0},
+		},
+	})
+}
+
+func TestFoodWeights(t *testing.T) {
+	tests := []struct {
+		name     string
+		weights  map[FoodKind]int32
+		wantKind FoodKind
+	}{
+		{name: "only golden food", weights: map[FoodKind]int32{GOLDEN_FOOD: 1}, wantKind: GOLDEN_FOOD},
+		{name: "only poison", weights: map[FoodKind]int32{NORMAL_FOOD: 0, POISON_FOOD: 2}, wantKind: POISON_FOOD},
+		{name: "no weights give normal food", weights: map[FoodKind]int32{}, wantKind: NORMAL_FOOD},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGame("food", 10, 10, 20, 1)
+			g.SetFoodWeights(test.weights)
+			g.NextState(map[int32]Direction{})
+
+			if len(g.Foods) != 20 {
+				t.Fatalf("%d foods, want 20", len(g.Foods))
+			}
+			for _, food := range g.Foods {
+				if food.Kind != test.wantKind {
+					t.Errorf("food at %v is %d, want %d", food.Coord, food.Kind, test.wantKind)
+				}
+			}
+		})
+	}
+}
+
+func TestRespawn(t *testing.T) {
+	tests := []struct {
+		name       string
+		halveScore bool
+		wantScore  int32
+	}{
+		{name: "score is kept", halveScore: false, wantScore: 5},
+		{name: "score is halved", halveScore: true, wantScore: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGame("respawn", 20, 20, 0, 1)
+			g.SetRespawn(3, test.halveScore)
+			g.Players[1] = NewPlayer(1, "player", 5, "", false)
+			g.Players[2] = NewPlayer(2, "player", 0, "", false)
+			// The snake of player 1 crashes into the snake of player 2 at the first turn
+			g.SetSnakes(map[int32]*Snake{
+				1: NewSnake(1, []Coord{NewCoord(2, 2), NewCoord(-1, 0)}, false, RIGHT, 0),
+				2: NewSnake(2, []Coord{NewCoord(3, 5), NewCoord(0, -4)}, false, DOWN, 0),
+			})
+
+			for turn := int32(1); turn <= 4; turn++ {
+				respawned := false
+				for _, event := range g.NextState(map[int32]Direction{}) {
+					respawned = respawned || event.Type == SNAKE_RESPAWNED && event.PlayerId == 1
+				}
+				_, hasSnake := g.Snakes[1]
+				if wantSnake := turn == 4; hasSnake != wantSnake || respawned != wantSnake {
+					t.Fatalf("turn %d: snake = %v, respawned = %v, want %v", turn, hasSnake, respawned, wantSnake)
+				}
+			}
+			if got := g.Players[1].Score; got != test.wantScore {
+				t.Errorf("score after the respawn = %d, want %d", got, test.wantScore)
+			}
+			if got := g.Players[1].RespawnTurn; got != 0 {
+				t.Errorf("respawn turn after the respawn = %d, want 0", got)
+			}
+		})
+	}
+}
+
+func TestShrinkArena(t *testing.T) {
+	// The 11x11 arena shrinks every 3 turns until it is 5x5
+	tests := []struct {
+		turn          int32
+		wantLevel     int32
+		wantNextTurn  int32
+		wantSnakeDies bool
+	}{
+		{turn: 1, wantLevel: 0, wantNextTurn: 3},
+		{turn: 2, wantLevel: 0, wantNextTurn: 3},
+		{turn: 3, wantLevel: 1, wantNextTurn: 6, wantSnakeDies: true},
+		{turn: 5, wantLevel: 1, wantNextTurn: 6},
+		{turn: 6, wantLevel: 2, wantNextTurn: 9},
+		{turn: 9, wantLevel: 3, wantNextTurn: 0},
+		{turn: 12, wantLevel: 3, wantNextTurn: 0},
+	}
+
+	g := NewGame("arena", 11, 11, 10, 1)
+	g.SetShrinkInterval(3)
+	g.Players[1] = NewPlayer(1, "player", 0, "", false)
+	g.SetSnakes(map[int32]*Snake{1: NewSnake(1, []Coord{NewCoord(5, 0), NewCoord(-1, 0)}, false, RIGHT, 0)})
+
+	for _, test := range tests {
+		snakeDies := false
+		for g.Turn < test.turn {
+			for _, event := range g.NextState(map[int32]Direction{}) {
+				snakeDies = snakeDies || event.Type == SNAKE_DIED && event.Cause == WALL
+			}
+		}
+		if g.ArenaLevel != test.wantLevel || g.NextShrinkTurn != test.wantNextTurn {
+			t.Errorf("turn %d: level %d, next shrink at %d, want %d at %d", test.turn, g.ArenaLevel,
+				g.NextShrinkTurn, test.wantLevel, test.wantNextTurn)
+		}
+		if snakeDies != test.wantSnakeDies {
+			t.Errorf("turn %d: snake on the border dies = %v, want %v", test.turn, snakeDies, test.wantSnakeDies)
+		}
+		if want := int(g.FoodStatic) + len(g.Players); len(g.Foods) != want {
+			t.Errorf("turn %d: %d foods, want %d", test.turn, len(g.Foods), want)
+		}
+		for _, food := range g.Foods {
+			if g.isArenaWall(food.Coord) {
+				t.Errorf("turn %d: food at %v is on the arena wall", test.turn, food.Coord)
+			}
+		}
+	}
+}
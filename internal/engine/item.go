@@ -0,0 +1,172 @@
+package engine
+
+import (
+	"math/rand"
+)
+
+type ItemKind int
+
+const (
+	SPEED_BOOST      ItemKind = 1 // The snake moves two cells per turn
+	SHRINK           ItemKind = 2 // The snake loses the last cells of its tail
+	GHOST            ItemKind = 3 // The snake passes through snakes
+	SCORE_MULTIPLIER ItemKind = 4 // The player gets more points
+)
+
+const (
+	itemSpawnChance = 10 // On average an item appears once in this number of turns
+	shrinkLength    = 2  // Number of cells the SHRINK item takes from the tail
+	scoreMultiplier = 2  // Multiplier of the points while SCORE_MULTIPLIER is active
+)
+
+// Properties of the item kind
+type ItemSpec struct {
+	Kind     ItemKind
+	Weight   int   // Relative chance of the kind to be spawned
+	Duration int32 // Number of turns the effect lasts (0 for instant items)
+}
+
+// Item kinds that can be spawned on the field, a new kind is added here and handled in pickUpItem
+var ItemSpecs = []ItemSpec{
+	{Kind: SPEED_BOOST, Weight: 3, Duration: 10},
+	{Kind: SHRINK, Weight: 3, Duration: 0},
+	{Kind: GHOST, Weight: 2, Duration: 10},
+	{Kind: SCORE_MULTIPLIER, Weight: 2, Duration: 20},
+}
+
+type Item struct {
+	Kind  ItemKind
+	Coord Coord
+}
+
+func NewItem(kind ItemKind, coord Coord) Item {
+	return Item{
+		Kind:  kind,
+		Coord: coord,
+	}
+}
+
+// Active effect of the item picked up by the snake
+type Effect struct {
+	Kind     ItemKind
+	Deadline int32 // Last turn when the effect is active
+}
+
+func NewEffect(kind ItemKind, deadline int32) Effect {
+	return Effect{
+		Kind:     kind,
+		Deadline: deadline,
+	}
+}
+
+func itemSpec(kind ItemKind) (ItemSpec, bool) {
+	for _, spec := range ItemSpecs {
+		if spec.Kind == kind {
+			return spec, true
+		}
+	}
+	return ItemSpec{}, false
+}
+
+func randomItemKind(random *rand.Rand) (ItemKind, bool) {
+	totalWeight := 0
+	for _, spec := range ItemSpecs {
+		totalWeight += spec.Weight
+	}
+	if totalWeight <= 0 {
+		return 0, false
+	}
+
+	weight := random.Intn(totalWeight)
+	for _, spec := range ItemSpecs {
+		if weight < spec.Weight {
+			return spec.Kind, true
+		}
+		weight -= spec.Weight
+	}
+	return 0, false
+}
+
+func (g *Game) SetItems(items []Item) {
+	g.Items = items
+	g.rebuildField()
+}
+
+func (g *Game) addItem() {
+	if int32(len(g.Items)) >= g.MaxItems || g.random.Intn(itemSpawnChance) != 0 {
+		return
+	}
+
+	kind, ok := randomItemKind(g.random)
+	if !ok {
+		return
+	}
+	coord, ok := findEmptyCell(g.field, g.random)
+	if !ok {
+		return
+	}
+
+	g.field.setItem(coord)
+	g.Items = append(g.Items, NewItem(kind, coord))
+	g.events = append(g.events, NewItemSpawnedEvent(coord, kind))
+}
+
+func (g *Game) deleteItem(coord Coord) (Item, bool) {
+	for idx, item := range g.Items {
+		if item.Coord == coord {
+			g.Items = append(g.Items[:idx], g.Items[idx+1:]...)
+			g.field.setEmpty(coord)
+			return item, true
+		}
+	}
+	return Item{}, false
+}
+
+func (g *Game) pickUpItem(playerId int32, coord Coord) {
+	item, ok := g.deleteItem(coord)
+	if !ok {
+		return
+	}
+	snake := g.Snakes[playerId]
+	g.events = append(g.events, NewItemPickedUpEvent(playerId, coord, item.Kind))
+
+	switch item.Kind {
+	case SHRINK:
+		g.shrinkSnake(playerId, shrinkLength)
+	default:
+		if spec, ok := itemSpec(item.Kind); ok && spec.Duration > 0 {
+			snake.addEffect(NewEffect(item.Kind, g.Turn+spec.Duration-1))
+		}
+	}
+}
+
+func (g *Game) expireEffects() {
+	for _, snake := range g.Snakes {
+		effects := make([]Effect, 0, len(snake.Effects))
+		for _, effect := range snake.Effects {
+			if effect.Deadline >= g.Turn {
+				effects = append(effects, effect)
+			}
+		}
+		snake.Effects = effects
+	}
+}
+
+func (g *Game) hasGhosts() bool {
+	for _, snake := range g.Snakes {
+		if snake.HasEffect(GHOST) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Game) boostedSnakeIds(snakeIds []int32) []int32 {
+	boostedIds := make([]int32, 0)
+	for _, playerId := range snakeIds {
+		if snake, ok := g.Snakes[playerId]; ok && snake.HasEffect(SPEED_BOOST) {
+			boostedIds = append(boostedIds, playerId)
+		}
+	}
+	return boostedIds
+}
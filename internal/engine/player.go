@@ -1,15 +1,23 @@
 package engine
 
 type Player struct {
-	Id    int32
-	Name  string
-	Score int32
+	Id          int32
+	Name        string
+	Score       int32
+	TokenHash   string // Hash of the token used to reclaim the snake after reconnection
+	Spectator   bool   // The player joined without a snake and does not get one in new rounds
+	Stats       *PlayerStats
+	RespawnTurn int32 // Turn when the player gets a new snake after the death (0 if not waiting for it)
+	Team        int32 // Team of the player (0 if the game has no teams)
 }
 
-func NewPlayer(id int32, name string, score int32) *Player {
+func NewPlayer(id int32, name string, score int32, tokenHash string, spectator bool) *Player {
 	return &Player{
-		Id:    id,
-		Name:  name,
-		Score: score,
+		Id:        id,
+		Name:      name,
+		Score:     score,
+		TokenHash: tokenHash,
+		Spectator: spectator,
+		Stats:     NewPlayerStats(),
 	}
 }
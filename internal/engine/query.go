@@ -0,0 +1,105 @@
+package engine
+
+// Content of the field cell
+type CellContent int
+
+const (
+	EMPTY_CELL CellContent = 1
+	FOOD_CELL  CellContent = 2
+	SNAKE_CELL CellContent = 3
+	WALL_CELL  CellContent = 4 // Wall or the cell outside the walled field
+	ITEM_CELL  CellContent = 5
+)
+
+// Returns the content of the cell and the owner of the snake occupying it (the coordinates outside the torus field
+// are wrapped)
+func (g *Game) Cell(coord Coord) (CellContent, int32) {
+	if g.Walled && (coord.x < 0 || coord.x >= g.Width || coord.y < 0 || coord.y >= g.Height) {
+		return WALL_CELL, 0
+	}
+
+	cell := g.field.get(g.field.neighbour(coord, 0, 0))
+	switch cell.state {
+	case cellState_FOOD:
+		return FOOD_CELL, 0
+	case cellState_SNAKE:
+		return SNAKE_CELL, cell.owner
+	case cellState_WALL:
+		return WALL_CELL, 0
+	case cellState_ITEM:
+		return ITEM_CELL, 0
+	}
+	return EMPTY_CELL, 0
+}
+
+// Returns the number of moves between the cells (in the torus field the shortest way may cross the edges)
+func (g *Game) Distance(from Coord, to Coord) int32 {
+	dx := abs(from.x - to.x)
+	dy := abs(from.y - to.y)
+	if !g.Walled {
+		dx = minOf(dx, g.Width-dx)
+		dy = minOf(dy, g.Height-dy)
+	}
+	return dx + dy
+}
+
+// Returns the cells next to the head of the snake it can move to without crashing, by the directions of the moves
+func (g *Game) FreeNeighbours(playerId int32) map[Direction]Coord {
+	neighbours := make(map[Direction]Coord)
+	snake, ok := g.Snakes[playerId]
+	if !ok {
+		return neighbours
+	}
+
+	head := snake.Points[0]
+	for _, direction := range []Direction{UP, LEFT, DOWN, RIGHT} {
+		if snake.moveDirection(direction) != direction {
+			continue
+		}
+		neighbour, ok := g.Neighbour(head, direction)
+		if content, _ := g.Cell(neighbour); ok && content != SNAKE_CELL && content != WALL_CELL {
+			neighbours[direction] = neighbour
+		}
+	}
+	return neighbours
+}
+
+// Returns the cell next to the given one in the direction, false if it is outside the walled field
+func (g *Game) Neighbour(coord Coord, direction Direction) (Coord, bool) {
+	if g.Walled && g.leavesField(coord, direction) {
+		return coord, false
+	}
+	offsetX, offsetY := directionOffset(direction)
+	return g.field.neighbour(coord, offsetX, offsetY), true
+}
+
+func (g *Game) FoodCells() []Coord {
+	cells := make([]Coord, len(g.Foods))
+	for idx, food := range g.Foods {
+		cells[idx] = food.Coord
+	}
+	return cells
+}
+
+// Returns all cells of the snake from the head to the tail
+func (g *Game) SnakeBody(playerId int32) ([]Coord, bool) {
+	snake, ok := g.Snakes[playerId]
+	if !ok {
+		return nil, false
+	}
+	return snake.convertToPoints(g.Width, g.Height), true
+}
+
+func directionOffset(direction Direction) (int32, int32) {
+	switch direction {
+	case UP:
+		return 0, -1
+	case DOWN:
+		return 0, 1
+	case LEFT:
+		return -1, 0
+	case RIGHT:
+		return 1, 0
+	}
+	return 0, 0
+}
@@ -0,0 +1,42 @@
+package engine
+
+func (g *Game) SetRespawn(respawnTurns int32, halveScore bool) {
+	g.RespawnTurns = respawnTurns
+	g.HalveScoreOnRespawn = halveScore
+}
+
+// Schedules new snakes for the players whose snakes died
+func (g *Game) scheduleRespawns(deaths []Event) {
+	if g.RespawnTurns <= 0 {
+		return
+	}
+	for _, death := range deaths {
+		if player, ok := g.Players[death.PlayerId]; ok && !player.Spectator {
+			player.RespawnTurn = g.Turn + g.RespawnTurns
+		}
+	}
+}
+
+// Gives new snakes to the players whose cooldown is over (if there is no room, the player waits for the next turn)
+func (g *Game) respawnSnakes() {
+	for _, playerId := range g.playerIds() {
+		player := g.Players[playerId]
+		if player.RespawnTurn == 0 || player.RespawnTurn > g.Turn {
+			continue
+		}
+		if _, ok := g.Snakes[playerId]; ok {
+			player.RespawnTurn = 0
+			continue
+		}
+		if err := g.addSnake(playerId); err != nil {
+			continue
+		}
+
+		player.RespawnTurn = 0
+		if g.HalveScoreOnRespawn {
+			g.addTeamPoints(playerId, player.Score/2-player.Score)
+			player.Score /= 2
+		}
+		g.events = append(g.events, NewSnakeRespawnedEvent(playerId, g.Snakes[playerId].Points[0]))
+	}
+}
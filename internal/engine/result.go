@@ -0,0 +1,74 @@
+package engine
+
+type FinishReason int
+
+const (
+	NOT_FINISHED FinishReason = 0
+	TURN_LIMIT   FinishReason = 1 // The game lasted the maximum number of turns
+	SCORE_LIMIT  FinishReason = 2 // A player reached the target score
+	LAST_SNAKE   FinishReason = 3 // At most one snake of the players is left alive
+)
+
+func (g *Game) checkFinish(aliveCountBefore int) {
+	aliveIds := g.aliveSnakeIds()
+
+	switch {
+	case g.LastSnakeStanding && aliveCountBefore > 1 && len(aliveIds) <= 1:
+		g.Finish = LAST_SNAKE
+		if len(aliveIds) == 1 {
+			g.WinnerId = aliveIds[0]
+		} else {
+			// The last snakes died at the same turn
+			g.WinnerId = g.leaderId()
+		}
+	case g.TargetScore > 0 && g.leaderScore() >= g.TargetScore:
+		g.Finish = SCORE_LIMIT
+		g.WinnerId = g.leaderId()
+	case g.MaxTurns > 0 && g.Turn-g.RoundStartTurn >= g.MaxTurns:
+		g.Finish = TURN_LIMIT
+		g.WinnerId = g.leaderId()
+	default:
+		return
+	}
+
+	g.events = append(g.events, NewGameFinishedEvent(g.WinnerId))
+}
+
+func (g *Game) aliveSnakeIds() []int32 {
+	aliveIds := make([]int32, 0)
+	for _, playerId := range g.snakeIds() {
+		if !g.Snakes[playerId].IsZombie {
+			aliveIds = append(aliveIds, playerId)
+		}
+	}
+	return aliveIds
+}
+
+func (g *Game) aliveSnakeCount() int {
+	return len(g.aliveSnakeIds())
+}
+
+func (g *Game) leaderScore() int32 {
+	score := int32(0)
+	for _, player := range g.Players {
+		if player.Score > score {
+			score = player.Score
+		}
+	}
+	return score
+}
+
+// Player with the highest score, 0 if several players share it
+func (g *Game) leaderId() int32 {
+	leaderId := int32(0)
+	score := int32(-1)
+	for playerId, player := range g.Players {
+		if player.Score > score {
+			leaderId = playerId
+			score = player.Score
+		} else if player.Score == score {
+			leaderId = 0
+		}
+	}
+	return leaderId
+}
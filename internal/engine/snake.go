@@ -18,16 +18,40 @@ type Snake struct {
 	Points        []Coord
 	IsZombie      bool
 	HeadDirection Direction
-	IsEating      bool
+	Growth        int32        // Number of cells the snake still has to grow by
+	Owner         *ZombieOwner // Player who can reclaim the zombie snake (nil if nobody can)
+	Effects       []Effect     // Active effects of the picked up items
 }
 
-func NewSnake(playerId int32, points []Coord, IsZombie bool, headDirection Direction, isEating bool) *Snake {
+type ZombieOwner struct {
+	Name      string
+	Score     int32
+	TokenHash string
+	Deadline  int32 // Last turn when the snake can be reclaimed
+	Team      int32
+	Stats     *PlayerStats // Statistics the player gets back with the snake
+}
+
+func NewZombieOwner(name string, score int32, tokenHash string, deadline int32, team int32,
+	stats *PlayerStats) *ZombieOwner {
+	return &ZombieOwner{
+		Name:      name,
+		Score:     score,
+		TokenHash: tokenHash,
+		Deadline:  deadline,
+		Team:      team,
+		Stats:     stats,
+	}
+}
+
+func NewSnake(playerId int32, points []Coord, IsZombie bool, headDirection Direction, growth int32) *Snake {
 	return &Snake{
 		PlayerId:      playerId,
 		Points:        points,
 		IsZombie:      IsZombie,
 		HeadDirection: headDirection,
-		IsEating:      isEating,
+		Growth:        growth,
+		Effects:       make([]Effect, 0),
 	}
 }
 
@@ -73,36 +97,88 @@ func (s *Snake) Move(direction Direction, width int32, height int32) {
 		s.HeadDirection = direction
 	}
 
-	if !s.IsEating { // The snake didn’t eat last turn and should shrink
-		tail := s.Points[len(s.Points)-1]
-		if tail.x != 0 {
-			if tail.x > 0 {
-				tail.x = tail.x - 1
-			} else {
-				tail.x = tail.x + 1
-			}
+	if s.Growth == 0 { // The snake has not got food to digest and should shrink
+		s.shrinkTail()
+	} else {
+		s.Growth--
+	}
+}
 
-			if tail.x == 0 { // The tail occupied one cell
-				s.Points = s.Points[:len(s.Points)-1]
-			} else {
-				s.Points[len(s.Points)-1] = tail
-			}
+func (s *Snake) shrinkTail() {
+	tail := s.Points[len(s.Points)-1]
+	if tail.x != 0 {
+		if tail.x > 0 {
+			tail.x = tail.x - 1
 		} else {
-			if tail.y > 0 {
-				tail.y = tail.y - 1
-			} else {
-				tail.y = tail.y + 1
-			}
+			tail.x = tail.x + 1
+		}
 
-			if tail.y == 0 { // The tail occupied one cell
-				s.Points = s.Points[:len(s.Points)-1]
-			} else {
-				s.Points[len(s.Points)-1] = tail
-			}
+		if tail.x == 0 { // The tail occupied one cell
+			s.Points = s.Points[:len(s.Points)-1]
+		} else {
+			s.Points[len(s.Points)-1] = tail
 		}
 	} else {
-		s.IsEating = false
+		if tail.y > 0 {
+			tail.y = tail.y - 1
+		} else {
+			tail.y = tail.y + 1
+		}
+
+		if tail.y == 0 { // The tail occupied one cell
+			s.Points = s.Points[:len(s.Points)-1]
+		} else {
+			s.Points[len(s.Points)-1] = tail
+		}
+	}
+}
+
+// Removes up to count cells from the tail keeping at least 2 cells, returns the removed cells
+func (s *Snake) shrink(count int32, width int32, height int32) []Coord {
+	removed := make([]Coord, 0, count)
+	for i := int32(0); i < count && s.length() > 2; i++ {
+		removed = append(removed, s.tail(width, height))
+		s.shrinkTail()
 	}
+	return removed
+}
+
+func (s *Snake) length() int32 {
+	length := int32(1)
+	for _, coord := range s.Points[1:] {
+		length += abs(coord.x) + abs(coord.y)
+	}
+	return length
+}
+
+func (s *Snake) HasEffect(kind ItemKind) bool {
+	for _, effect := range s.Effects {
+		if effect.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Adds the effect or prolongs the active effect of the same kind
+func (s *Snake) addEffect(effect Effect) {
+	for idx := range s.Effects {
+		if s.Effects[idx].Kind == effect.Kind {
+			if s.Effects[idx].Deadline < effect.Deadline {
+				s.Effects[idx].Deadline = effect.Deadline
+			}
+			return
+		}
+	}
+	s.Effects = append(s.Effects, effect)
+}
+
+// Direction in which the snake actually moves (the opposite direction is ignored)
+func (s *Snake) moveDirection(direction Direction) Direction {
+	if s.HeadDirection-direction == 2 || s.HeadDirection-direction == -2 {
+		return s.HeadDirection
+	}
+	return direction
 }
 
 func (s *Snake) convertToPoints(width int32, height int32) []Coord {
@@ -146,3 +222,14 @@ func (s *Snake) convertToPoints(width int32, height int32) []Coord {
 
 	return points
 }
+
+func (s *Snake) tail(width int32, height int32) Coord {
+	x := s.Points[0].x
+	y := s.Points[0].y
+	for _, coord := range s.Points[1:] {
+		x = ((x+coord.x)%width + width) % width
+		y = ((y+coord.y)%height + height) % height
+	}
+
+	return NewCoord(x, y)
+}
@@ -0,0 +1,57 @@
+package engine
+
+// Statistics of the player collected during the game
+type PlayerStats struct {
+	FoodEaten     int32
+	Kills         int32 // Number of snakes that crashed into the player's snake
+	Deaths        int32
+	DeathCauses   map[DeathCause]int32
+	MaxLength     int32
+	TicksSurvived int32
+	Turns         int32 // Number of times the snake changed its direction
+}
+
+func NewPlayerStats() *PlayerStats {
+	return &PlayerStats{
+		DeathCauses: make(map[DeathCause]int32),
+	}
+}
+
+// Updates the statistics of the players with the events of the tick and the snakes left alive
+func (g *Game) updateStats(events []Event) {
+	for _, event := range events {
+		switch event.Type {
+		case FOOD_EATEN:
+			if player, ok := g.Players[event.PlayerId]; ok {
+				player.Stats.FoodEaten++
+			}
+		case SNAKE_DIED:
+			if player, ok := g.Players[event.PlayerId]; ok {
+				player.Stats.Deaths++
+				player.Stats.DeathCauses[event.Cause]++
+			}
+			for _, killerId := range event.KillerIds {
+				if killer, ok := g.Players[killerId]; ok {
+					killer.Stats.Kills++
+				}
+			}
+		}
+	}
+
+	for playerId, snake := range g.Snakes {
+		player, ok := g.Players[playerId]
+		if !ok || snake.IsZombie {
+			continue
+		}
+		player.Stats.TicksSurvived++
+		if length := snake.length(); length > player.Stats.MaxLength {
+			player.Stats.MaxLength = length
+		}
+	}
+}
+
+func (g *Game) countTurn(playerId int32) {
+	if player, ok := g.Players[playerId]; ok {
+		player.Stats.Turns++
+	}
+}
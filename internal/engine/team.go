@@ -0,0 +1,55 @@
+package engine
+
+func (g *Game) SetTeams(teamCount int32, safeTeammates bool) {
+	g.TeamCount = teamCount
+	g.SafeTeammates = safeTeammates
+}
+
+func (g *Game) SetTeamScores(teamScores map[int32]int32) {
+	g.TeamScores = teamScores
+}
+
+// Returns the identifiers of the players of every team (teams are numbered from 1)
+func (g *Game) TeamRosters() map[int32][]int32 {
+	rosters := make(map[int32][]int32)
+	for team := int32(1); team <= g.TeamCount; team++ {
+		rosters[team] = make([]int32, 0)
+	}
+	for _, playerId := range g.playerIds() {
+		if team := g.Players[playerId].Team; team != 0 {
+			rosters[team] = append(rosters[team], playerId)
+		}
+	}
+	return rosters
+}
+
+// Returns the requested team or, if it is not chosen, the team with the fewest players (0 if there are no teams)
+func (g *Game) chooseTeam(team int32) int32 {
+	if g.TeamCount <= 0 {
+		return 0
+	}
+	if team >= 1 && team <= g.TeamCount {
+		return team
+	}
+
+	rosters := g.TeamRosters()
+	smallestTeam := int32(1)
+	for team := int32(2); team <= g.TeamCount; team++ {
+		if len(rosters[team]) < len(rosters[smallestTeam]) {
+			smallestTeam = team
+		}
+	}
+	return smallestTeam
+}
+
+func (g *Game) areTeammates(playerId int32, otherPlayerId int32) bool {
+	player, ok := g.Players[playerId]
+	otherPlayer, otherOk := g.Players[otherPlayerId]
+	return ok && otherOk && player.Team != 0 && player.Team == otherPlayer.Team
+}
+
+func (g *Game) addTeamPoints(playerId int32, points int32) {
+	if player, ok := g.Players[playerId]; ok && player.Team != 0 {
+		g.TeamScores[player.Team] += points
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"p2p-snake/internal/log"
 	"p2p-snake/internal/p2p/dto"
+	"p2p-snake/internal/p2p/protocol"
 )
 
 const (
@@ -18,23 +19,23 @@ type Announcement struct {
 	lastUpdate time.Time
 	addr       *net.UDPAddr
 
-	gameName   string
-	width      int32
-	height     int32
-	foodStatic int32
-	stateDelay int32
+	gameName string
+	config   *protocol.GameConfig
+	botCount int32
+	teams    []dto.TeamRosterDto
+	canJoin  bool
 }
 
-func NewAnnouncement(addr *net.UDPAddr, gameName string, width int32, height int32, foodStatic int32, stateDelay int32) *Announcement {
+func NewAnnouncement(addr *net.UDPAddr, gameName string, config *protocol.GameConfig, botCount int32, teams []dto.TeamRosterDto, canJoin bool) *Announcement {
 	return &Announcement{
 		lastUpdate: time.Now(),
 		addr:       addr,
 
-		gameName:   gameName,
-		width:      width,
-		height:     height,
-		foodStatic: foodStatic,
-		stateDelay: stateDelay,
+		gameName: gameName,
+		config:   config,
+		botCount: botCount,
+		teams:    teams,
+		canJoin:  canJoin,
 	}
 }
 
@@ -46,20 +47,52 @@ func (a Announcement) GameName() string {
 	return a.gameName
 }
 
+func (a Announcement) Config() *protocol.GameConfig {
+	return a.config
+}
+
 func (a Announcement) Width() int32 {
-	return a.width
+	return a.config.GetWidth()
 }
 
 func (a Announcement) Height() int32 {
-	return a.height
+	return a.config.GetHeight()
 }
 
 func (a Announcement) FoodStatic() int32 {
-	return a.foodStatic
+	return a.config.GetFoodStatic()
 }
 
 func (a Announcement) StateDelay() int32 {
-	return a.stateDelay
+	return a.config.GetStateDelayMs()
+}
+
+func (a Announcement) Walled() bool {
+	return a.config.GetWalled()
+}
+
+func (a Announcement) Walls() []*protocol.GameState_Coord {
+	return a.config.GetWalls()
+}
+
+func (a Announcement) SpawnPoints() []*protocol.GameState_Coord {
+	return a.config.GetSpawnPoints()
+}
+
+func (a Announcement) FoodSpots() []*protocol.GameState_Coord {
+	return a.config.GetFoodSpots()
+}
+
+func (a Announcement) BotCount() int32 {
+	return a.botCount
+}
+
+func (a Announcement) Teams() []dto.TeamRosterDto {
+	return a.teams
+}
+
+func (a Announcement) CanJoin() bool {
+	return a.canJoin
 }
 
 type AnnouncementCollector struct {
@@ -92,6 +125,9 @@ func (collector *AnnouncementCollector) Start() {
 func (collector *AnnouncementCollector) AddAnnouncement(announcement *Announcement) {
 	if announcedGame, ok := collector.announcements[announcement.gameName]; ok {
 		announcedGame.lastUpdate = time.Now()
+		announcedGame.botCount = announcement.botCount
+		announcedGame.teams = announcement.teams
+		announcedGame.canJoin = announcement.canJoin
 		log.Logger.Debugf("Announcement \"%s\" updated", announcement.gameName)
 	} else {
 		collector.announcements[announcement.gameName] = announcement
@@ -125,6 +161,10 @@ func (collector *AnnouncementCollector) GetGameInfoDtos() []dto.GameInfoDto {
 			announcement.Width(),
 			announcement.Height(),
 			announcement.StateDelay(),
+			announcement.Walled(),
+			announcement.BotCount(),
+			announcement.Teams(),
+			announcement.CanJoin(),
 		))
 	}
 	return gameInfoDtos
@@ -0,0 +1,60 @@
+package bot
+
+import (
+	"sync"
+
+	"p2p-snake/internal/p2p/protocol"
+)
+
+// Keeps strategies of the robot snakes driven by the node
+type Driver struct {
+	strategies      map[int32]Strategy
+	defaultStrategy Strategy
+
+	lock *sync.RWMutex
+}
+
+func NewDriver() *Driver {
+	return &Driver{
+		strategies:      make(map[int32]Strategy),
+		defaultStrategy: NewSurvivalStrategy(),
+
+		lock: &sync.RWMutex{},
+	}
+}
+
+func (d *Driver) Add(playerId int32, strategy Strategy) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.strategies[playerId] = strategy
+}
+
+func (d *Driver) Delete(playerId int32) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.strategies, playerId)
+}
+
+func (d *Driver) Clear() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.strategies = make(map[int32]Strategy)
+}
+
+func (d *Driver) Strategy(playerId int32) Strategy {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	// Robots taken over from the previous MASTER are driven by the default strategy
+	if strategy, ok := d.strategies[playerId]; ok {
+		return strategy
+	}
+	return d.defaultStrategy
+}
+
+func (d *Driver) NextDirection(view *View, playerId int32) (protocol.Direction, bool) {
+	if _, ok := view.Head(playerId); !ok {
+		return 0, false
+	}
+	return d.Strategy(playerId).NextDirection(view, playerId), true
+}
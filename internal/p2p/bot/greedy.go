@@ -0,0 +1,49 @@
+package bot
+
+import (
+	"math"
+
+	"p2p-snake/internal/p2p/protocol"
+)
+
+// Moves towards the nearest food avoiding only the cells next to the head
+type GreedyStrategy struct{}
+
+func NewGreedyStrategy() *GreedyStrategy {
+	return &GreedyStrategy{}
+}
+
+func (s *GreedyStrategy) Name() string {
+	return GreedyStrategyName
+}
+
+func (s *GreedyStrategy) NextDirection(view *View, playerId int32) protocol.Direction {
+	head, ok := view.Head(playerId)
+	if !ok {
+		return view.Direction(playerId)
+	}
+
+	bestDirection := view.Direction(playerId)
+	bestDistance := int32(math.MaxInt32)
+	for _, direction := range view.Directions(playerId) {
+		next, ok := view.Move(head, direction)
+		if !ok || !view.IsFree(next) {
+			continue
+		}
+
+		distance := int32(0)
+		if len(view.Foods()) > 0 {
+			distance = math.MaxInt32
+			for _, food := range view.Foods() {
+				distance = min(distance, view.Distance(next, food))
+			}
+		}
+
+		if distance < bestDistance {
+			bestDirection = direction
+			bestDistance = distance
+		}
+	}
+
+	return bestDirection
+}
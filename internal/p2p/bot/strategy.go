@@ -0,0 +1,27 @@
+package bot
+
+import (
+	"fmt"
+
+	"p2p-snake/internal/p2p/protocol"
+)
+
+const (
+	GreedyStrategyName   = "greedy"
+	SurvivalStrategyName = "survival"
+)
+
+type Strategy interface {
+	Name() string
+	NextDirection(view *View, playerId int32) protocol.Direction
+}
+
+func NewStrategy(name string) (Strategy, error) {
+	switch name {
+	case GreedyStrategyName:
+		return NewGreedyStrategy(), nil
+	case SurvivalStrategyName:
+		return NewSurvivalStrategy(), nil
+	}
+	return nil, fmt.Errorf("unknown robot strategy \"%s\"", name)
+}
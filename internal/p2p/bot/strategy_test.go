@@ -0,0 +1,93 @@
+package bot
+
+import (
+	"testing"
+
+	"p2p-snake/internal/engine"
+	"p2p-snake/internal/p2p/protocol"
+)
+
+// The robot of player 1 never turns into a snake standing next to its head, even with the food behind it
+func TestStrategyAvoidsCollision(t *testing.T) {
+	tests := []struct {
+		name   string
+		snakes map[int32]*engine.Snake
+		foods  []engine.Food
+	}{
+		{
+			name: "other snake ahead",
+			snakes: map[int32]*engine.Snake{
+				1: engine.NewSnake(1, []engine.Coord{engine.NewCoord(5, 5), engine.NewCoord(-1, 0)}, false,
+					engine.RIGHT, 0),
+				2: engine.NewSnake(2, []engine.Coord{engine.NewCoord(6, 2), engine.NewCoord(0, 6)}, false,
+					engine.UP, 0),
+			},
+			foods: []engine.Food{engine.NewFood(engine.NORMAL_FOOD, engine.NewCoord(7, 5))},
+		},
+		{
+			name: "own body ahead",
+			snakes: map[int32]*engine.Snake{
+				1: engine.NewSnake(1, []engine.Coord{engine.NewCoord(5, 5), engine.NewCoord(1, 0),
+					engine.NewCoord(0, -1), engine.NewCoord(-2, 0)}, false, engine.UP, 0),
+			},
+			foods: []engine.Food{engine.NewFood(engine.NORMAL_FOOD, engine.NewCoord(5, 2))},
+		},
+		{
+			name: "other snake between the head and the food",
+			snakes: map[int32]*engine.Snake{
+				1: engine.NewSnake(1, []engine.Coord{engine.NewCoord(5, 5), engine.NewCoord(0, 1)}, false,
+					engine.UP, 0),
+				2: engine.NewSnake(2, []engine.Coord{engine.NewCoord(2, 4), engine.NewCoord(6, 0)}, false,
+					engine.LEFT, 0),
+			},
+			foods: []engine.Food{engine.NewFood(engine.NORMAL_FOOD, engine.NewCoord(5, 3))},
+		},
+	}
+
+	strategies := []Strategy{NewGreedyStrategy(), NewSurvivalStrategy()}
+	for _, test := range tests {
+		for _, strategy := range strategies {
+			t.Run(test.name+"/"+strategy.Name(), func(t *testing.T) {
+				g := engine.NewGame("bot", 10, 10, 0, 1)
+				g.SetSnakes(test.snakes)
+				g.SetFoods(test.foods)
+				view := NewView(g)
+
+				direction := strategy.NextDirection(view, 1)
+				head, _ := view.Head(1)
+				next, ok := view.Move(head, direction)
+				if !ok || !view.IsFree(next) {
+					t.Errorf("direction %v leads from %v to occupied %v", direction, head, next)
+				}
+				if direction == opposite(view.Direction(1)) {
+					t.Errorf("direction %v is opposite to the current one", direction)
+				}
+			})
+		}
+	}
+}
+
+func TestDriverNextDirection(t *testing.T) {
+	g := engine.NewGame("bot", 10, 10, 0, 1)
+	g.SetSnakes(map[int32]*engine.Snake{
+		1: engine.NewSnake(1, []engine.Coord{engine.NewCoord(5, 5), engine.NewCoord(-1, 0)}, false, engine.RIGHT, 0),
+	})
+	view := NewView(g)
+
+	driver := NewDriver()
+	driver.Add(1, NewGreedyStrategy())
+	if direction, ok := driver.NextDirection(view, 1); !ok || direction != protocol.Direction_RIGHT {
+		t.Errorf("NextDirection(1) = %v, %v, want RIGHT", direction, ok)
+	}
+
+	// The robot without a snake is not steered
+	if _, ok := driver.NextDirection(view, 2); ok {
+		t.Error("robot without a snake is steered")
+	}
+
+	// The deleted robot is driven by the default strategy
+	driver.Delete(1)
+	if got := driver.Strategy(1).Name(); got != SurvivalStrategyName {
+		t.Errorf("strategy of the deleted robot = %s, want %s", got, SurvivalStrategyName)
+	}
+}
@@ -0,0 +1,118 @@
+package bot
+
+import (
+	"math"
+
+	"p2p-snake/internal/engine"
+	"p2p-snake/internal/p2p/protocol"
+)
+
+// Chooses the direction leading to the largest free area (flood fill on the torus), avoids possible head-on
+// collisions and goes to the nearest reachable food when several directions are equally safe
+type SurvivalStrategy struct{}
+
+func NewSurvivalStrategy() *SurvivalStrategy {
+	return &SurvivalStrategy{}
+}
+
+func (s *SurvivalStrategy) Name() string {
+	return SurvivalStrategyName
+}
+
+type option struct {
+	direction    protocol.Direction
+	isRoomy      bool  // The area is large enough to fit the snake
+	isRisky      bool  // Other head can move to the same cell
+	area         int32 // Number of reachable free cells
+	foodDistance int32 // Path length to the nearest reachable food
+}
+
+func (o option) isBetterThan(other option) bool {
+	if o.isRoomy != other.isRoomy {
+		return o.isRoomy
+	}
+	if o.isRisky != other.isRisky {
+		return !o.isRisky
+	}
+	if !o.isRoomy && o.area != other.area {
+		return o.area > other.area
+	}
+	return o.foodDistance < other.foodDistance
+}
+
+func (s *SurvivalStrategy) NextDirection(view *View, playerId int32) protocol.Direction {
+	head, ok := view.Head(playerId)
+	if !ok {
+		return view.Direction(playerId)
+	}
+
+	var best *option
+	for _, direction := range view.Directions(playerId) {
+		next, ok := view.Move(head, direction)
+		if !ok || !view.IsFree(next) {
+			continue
+		}
+
+		needArea := 2*view.Length(playerId) + 1
+		area, foodDistance := floodFill(view, next, needArea)
+		current := option{
+			direction:    direction,
+			isRoomy:      area >= needArea,
+			isRisky:      isNearOtherHead(view, playerId, next),
+			area:         area,
+			foodDistance: foodDistance,
+		}
+		if best == nil || current.isBetterThan(*best) {
+			best = &current
+		}
+	}
+
+	if best == nil {
+		return view.Direction(playerId)
+	}
+	return best.direction
+}
+
+// Counts free cells reachable from the start cell (up to the limit) and finds the path length to the nearest
+// food
+func floodFill(view *View, start engine.Coord, limit int32) (int32, int32) {
+	distances := make([]int32, view.Width()*view.Height())
+	for idx := range distances {
+		distances[idx] = -1
+	}
+	distances[start.Y()*view.Width()+start.X()] = 0
+
+	area := int32(1)
+	foodDistance := int32(math.MaxInt32)
+	queue := []engine.Coord{start}
+	for len(queue) > 0 && (area < limit || foodDistance == math.MaxInt32) {
+		point := queue[0]
+		queue = queue[1:]
+		distance := distances[point.Y()*view.Width()+point.X()]
+		if view.IsFood(point) && distance < foodDistance {
+			foodDistance = distance
+		}
+
+		for _, direction := range []protocol.Direction{
+			protocol.Direction_UP, protocol.Direction_DOWN, protocol.Direction_LEFT, protocol.Direction_RIGHT,
+		} {
+			next, ok := view.Move(point, direction)
+			if nextIdx := next.Y()*view.Width() + next.X(); ok && distances[nextIdx] < 0 && view.IsFree(next) {
+				distances[nextIdx] = distance + 1
+				area++
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return area, foodDistance
+}
+
+func isNearOtherHead(view *View, playerId int32, point engine.Coord) bool {
+	for otherPlayerId, otherHead := range view.Heads() {
+		if otherPlayerId != playerId && view.Distance(point, otherHead) == 1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package bot
+
+import (
+	"p2p-snake/internal/engine"
+	"p2p-snake/internal/p2p/protocol"
+)
+
+// Snapshot of the game used by strategies to choose a direction
+type View struct {
+	game  *engine.Game
+	heads map[int32]engine.Coord
+	foods []engine.Coord
+	food  map[engine.Coord]bool
+}
+
+// Creates the view of the game copy, robots do not go for poison food
+func NewView(game *engine.Game) *View {
+	view := &View{
+		game:  game,
+		heads: make(map[int32]engine.Coord),
+		foods: make([]engine.Coord, 0, len(game.Foods)),
+		food:  make(map[engine.Coord]bool),
+	}
+
+	for _, food := range game.Foods {
+		if food.Kind != engine.POISON_FOOD {
+			view.foods = append(view.foods, food.Coord)
+			view.food[food.Coord] = true
+		}
+	}
+
+	for playerId, snake := range game.Snakes {
+		if len(snake.Points) > 0 {
+			view.heads[playerId] = snake.Points[0]
+		}
+	}
+
+	return view
+}
+
+func (v *View) Width() int32 {
+	return v.game.Width
+}
+
+func (v *View) Height() int32 {
+	return v.game.Height
+}
+
+func (v *View) Foods() []engine.Coord {
+	return v.foods
+}
+
+func (v *View) Head(playerId int32) (engine.Coord, bool) {
+	head, ok := v.heads[playerId]
+	return head, ok
+}
+
+func (v *View) Heads() map[int32]engine.Coord {
+	return v.heads
+}
+
+func (v *View) Direction(playerId int32) protocol.Direction {
+	if snake, ok := v.game.Snakes[playerId]; ok {
+		return fromEngineDirection(snake.HeadDirection)
+	}
+	return 0
+}
+
+func (v *View) Length(playerId int32) int32 {
+	body, _ := v.game.SnakeBody(playerId)
+	return int32(len(body))
+}
+
+// Directions the snake can turn to (all except the opposite one), the current direction goes first
+func (v *View) Directions(playerId int32) []protocol.Direction {
+	current := v.Direction(playerId)
+	directions := []protocol.Direction{current}
+	for _, direction := range []protocol.Direction{
+		protocol.Direction_UP, protocol.Direction_DOWN, protocol.Direction_LEFT, protocol.Direction_RIGHT,
+	} {
+		if direction != current && direction != opposite(current) {
+			directions = append(directions, direction)
+		}
+	}
+	return directions
+}
+
+// Returns the neighbour cell in the direction, false if the cell is outside the walled field
+func (v *View) Move(point engine.Coord, direction protocol.Direction) (engine.Coord, bool) {
+	return v.game.Neighbour(point, toEngineDirection(direction))
+}
+
+// Items are picked up on the way, only snakes and walls are in the way
+func (v *View) IsFree(point engine.Coord) bool {
+	content, _ := v.game.Cell(point)
+	return content != engine.SNAKE_CELL && content != engine.WALL_CELL
+}
+
+func (v *View) IsFood(point engine.Coord) bool {
+	return v.food[point]
+}
+
+func (v *View) Distance(a engine.Coord, b engine.Coord) int32 {
+	return v.game.Distance(a, b)
+}
+
+func opposite(direction protocol.Direction) protocol.Direction {
+	switch direction {
+	case protocol.Direction_UP:
+		return protocol.Direction_DOWN
+	case protocol.Direction_DOWN:
+		return protocol.Direction_UP
+	case protocol.Direction_LEFT:
+		return protocol.Direction_RIGHT
+	case protocol.Direction_RIGHT:
+		return protocol.Direction_LEFT
+	}
+	return direction
+}
+
+func toEngineDirection(direction protocol.Direction) engine.Direction {
+	switch direction {
+	case protocol.Direction_UP:
+		return engine.UP
+	case protocol.Direction_DOWN:
+		return engine.DOWN
+	case protocol.Direction_LEFT:
+		return engine.LEFT
+	case protocol.Direction_RIGHT:
+		return engine.RIGHT
+	}
+	return 0
+}
+
+func fromEngineDirection(direction engine.Direction) protocol.Direction {
+	switch direction {
+	case engine.UP:
+		return protocol.Direction_UP
+	case engine.DOWN:
+		return protocol.Direction_DOWN
+	case engine.LEFT:
+		return protocol.Direction_LEFT
+	case engine.RIGHT:
+		return protocol.Direction_RIGHT
+	}
+	return 0
+}
+
+func min(a int32, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,126 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"p2p-snake/internal/p2p/protocol"
+)
+
+var (
+	notAcknowledgedError = fmt.Errorf("message is not acknowledged")
+	ackTimeoutError      = fmt.Errorf("acknowledgement timeout")
+)
+
+// Identifies the message waiting for the acknowledgement: the response must come from the same peer with the same
+// msg_seq
+type ackKey struct {
+	peer   string
+	msgSeq int64
+}
+
+func newAckKey(peer *net.UDPAddr, msgSeq int64) ackKey {
+	return ackKey{peer: peer.String(), msgSeq: msgSeq}
+}
+
+type ackWaiter struct {
+	key      ackKey
+	response chan *protocol.GameMessage // Buffered, receives exactly one value: AckMsg, ErrorMsg or nil on failure
+	renewed  chan struct{}              // Buffered, the message has been redirected and is sent again from scratch
+}
+
+// Matches AckMsg and ErrorMsg with the messages waiting for them, safe for concurrent use
+type correlator struct {
+	waiters map[ackKey]*ackWaiter
+	lock    *sync.Mutex
+}
+
+func newCorrelator() *correlator {
+	return &correlator{
+		waiters: make(map[ackKey]*ackWaiter),
+		lock:    &sync.Mutex{},
+	}
+}
+
+func (c *correlator) register(key ackKey) *ackWaiter {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	waiter := &ackWaiter{
+		key:      key,
+		response: make(chan *protocol.GameMessage, 1),
+		renewed:  make(chan struct{}, 1),
+	}
+	c.waiters[key] = waiter
+	return waiter
+}
+
+// Passes the response to the waiter, returns false if nobody waits for it
+func (c *correlator) resolve(key ackKey, response *protocol.GameMessage) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	waiter, ok := c.waiters[key]
+	if !ok {
+		return false
+	}
+	delete(c.waiters, key)
+	select {
+	case waiter.response <- response:
+	default:
+	}
+	return true
+}
+
+// Ends the waiting with the failure
+func (c *correlator) fail(key ackKey) bool {
+	return c.resolve(key, nil)
+}
+
+// Waits for the message to come from the new peer, the timeout of the waiting starts again
+func (c *correlator) rekey(key ackKey, peer *net.UDPAddr) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	waiter, ok := c.waiters[key]
+	if !ok {
+		return
+	}
+	delete(c.waiters, key)
+	waiter.key = newAckKey(peer, key.msgSeq)
+	c.waiters[waiter.key] = waiter
+	select {
+	case waiter.renewed <- struct{}{}:
+	default:
+	}
+}
+
+// Waits for the response until the timeout or the cancellation of the context
+func (c *correlator) await(ctx context.Context, waiter *ackWaiter, timeout time.Duration) (*protocol.GameMessage, error) {
+	deadline := time.After(timeout)
+	for {
+		select {
+		case response := <-waiter.response:
+			if response == nil {
+				return nil, notAcknowledgedError
+			}
+			return response, nil
+		case <-waiter.renewed:
+			deadline = time.After(timeout)
+		case <-deadline:
+			c.forget(waiter)
+			return nil, ackTimeoutError
+		case <-ctx.Done():
+			c.forget(waiter)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (c *correlator) forget(waiter *ackWaiter) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.waiters[waiter.key] == waiter {
+		delete(c.waiters, waiter.key)
+	}
+}
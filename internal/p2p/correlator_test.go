@@ -0,0 +1,98 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"p2p-snake/internal/p2p/protocol"
+)
+
+// Registers, acknowledges, fails, redirects, expires and cancels the waiters concurrently, run with -race
+func TestCorrelatorConcurrent(t *testing.T) {
+	const count = 4000
+
+	c := newCorrelator()
+	oldPeer := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1}
+	newPeer := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 2}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var acknowledged, failed, expired, cancelled atomic.Int32
+	awaited := &sync.WaitGroup{}
+	responders := &sync.WaitGroup{}
+	for seq := int64(0); seq < count; seq++ {
+		key := newAckKey(oldPeer, seq)
+		waiter := c.register(key)
+
+		// Every fifth message is never answered and expires, every fifth is cancelled with the context
+		timeout := time.Minute
+		if seq%5 == 3 {
+			timeout = 10 * time.Millisecond
+		}
+		awaited.Add(1)
+		go func() {
+			defer awaited.Done()
+			response, err := c.await(ctx, waiter, timeout)
+			switch {
+			case err == nil && response.GetAck() != nil:
+				acknowledged.Add(1)
+			case errors.Is(err, notAcknowledgedError):
+				failed.Add(1)
+			case errors.Is(err, ackTimeoutError):
+				expired.Add(1)
+			case errors.Is(err, context.Canceled):
+				cancelled.Add(1)
+			default:
+				t.Errorf("message %d: unexpected result %v, %v", key.msgSeq, response, err)
+			}
+		}()
+
+		responders.Add(1)
+		go func(seq int64) {
+			defer responders.Done()
+			ack := protocol.NewAckMsg(seq, 1, 2)
+			switch seq % 5 {
+			case 0:
+				// The response is resent, only the first one is passed
+				c.resolve(newAckKey(oldPeer, seq), ack)
+				c.resolve(newAckKey(oldPeer, seq), ack)
+			case 1:
+				// The message is redirected, the old peer cannot answer it anymore
+				c.rekey(newAckKey(oldPeer, seq), newPeer)
+				if c.resolve(newAckKey(oldPeer, seq), ack) {
+					t.Errorf("message %d: resolved by the old peer after the redirection", seq)
+				}
+				c.resolve(newAckKey(newPeer, seq), ack)
+			case 2:
+				c.fail(newAckKey(oldPeer, seq))
+				c.resolve(newAckKey(oldPeer, seq), ack)
+			}
+		}(seq)
+	}
+
+	responders.Wait()
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	awaited.Wait()
+
+	if got := acknowledged.Load(); got != 2*count/5 {
+		t.Errorf("acknowledged = %d, want %d", got, 2*count/5)
+	}
+	if got := failed.Load(); got != count/5 {
+		t.Errorf("failed = %d, want %d", got, count/5)
+	}
+	if got := expired.Load(); got != count/5 {
+		t.Errorf("expired = %d, want %d", got, count/5)
+	}
+	if got := cancelled.Load(); got != count/5 {
+		t.Errorf("cancelled = %d, want %d", got, count/5)
+	}
+	if len(c.waiters) != 0 {
+		t.Errorf("%d waiters are left", len(c.waiters))
+	}
+}
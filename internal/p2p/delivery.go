@@ -0,0 +1,188 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"p2p-snake/internal/log"
+	"p2p-snake/internal/p2p/protocol"
+)
+
+const (
+	maxSendAttempts  = 8                     // With the resend interval state_delay/10 the message is sent for 0.8 * state_delay
+	retransmitPeriod = 10 * time.Millisecond // The smallest resend interval (state_delay is at least 100 ms)
+)
+
+// Result of the delivery of the message that has to be acknowledged
+type deliveryResult struct {
+	response *protocol.GameMessage // AckMsg or ErrorMsg, nil if the message was not delivered
+	err      error
+}
+
+// Message waiting for the acknowledgement
+type pendingMsg struct {
+	msg          *protocol.GameMessage
+	addr         *net.UDPAddr
+	receiverRole *protocol.NodeRole // MASTER if the message follows the MASTER after its change, nil otherwise
+	interval     time.Duration      // Resend interval
+	attempts     int32
+	lastSend     time.Time
+}
+
+func (m *pendingMsg) key() ackKey {
+	return newAckKey(m.addr, m.msg.GetMsgSeq())
+}
+
+// Messages waiting for the acknowledgements grouped by the destination
+type retransmitQueue struct {
+	queues map[string][]*pendingMsg
+	lock   *sync.Mutex
+}
+
+func newRetransmitQueue() *retransmitQueue {
+	return &retransmitQueue{
+		queues: make(map[string][]*pendingMsg),
+		lock:   &sync.Mutex{},
+	}
+}
+
+func (q *retransmitQueue) push(pending *pendingMsg) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	key := pending.addr.String()
+	q.queues[key] = append(q.queues[key], pending)
+}
+
+// Stops resending the acknowledged message
+func (q *retransmitQueue) acknowledge(key ackKey) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for idx, pending := range q.queues[key.peer] {
+		if pending.msg.GetMsgSeq() == key.msgSeq {
+			q.removeAt(key.peer, idx)
+			return
+		}
+	}
+}
+
+// Returns the messages to be resent and the messages that have used all attempts, the latter are removed
+func (q *retransmitQueue) due(now time.Time) ([]pendingMsg, []ackKey) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	resent := make([]pendingMsg, 0)
+	expired := make([]ackKey, 0)
+	for peer, queue := range q.queues {
+		for idx := len(queue) - 1; idx >= 0; idx-- {
+			pending := queue[idx]
+			if now.Sub(pending.lastSend) < pending.interval {
+				continue
+			}
+			if pending.attempts >= maxSendAttempts {
+				q.removeAt(peer, idx)
+				expired = append(expired, pending.key())
+				continue
+			}
+			pending.attempts++
+			pending.lastSend = now
+			resent = append(resent, *pending)
+		}
+	}
+	return resent, expired
+}
+
+// Redirects the messages addressed to the role to the new receiver, the messages are sent to it with all attempts.
+// Returns the old keys of the redirected messages
+func (q *retransmitQueue) retarget(role protocol.NodeRole, receiverId int32, addr *net.UDPAddr) []ackKey {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	redirected := make([]ackKey, 0)
+	newPeer := addr.String()
+	for peer, queue := range q.queues {
+		for idx := len(queue) - 1; idx >= 0; idx-- {
+			pending := queue[idx]
+			if pending.receiverRole == nil || *pending.receiverRole != role || peer == newPeer {
+				continue
+			}
+			q.removeAt(peer, idx)
+			redirected = append(redirected, pending.key())
+
+			// The message may be being sent right now, so it is copied rather than changed
+			msg := proto.Clone(pending.msg).(*protocol.GameMessage)
+			msg.ReceiverId = proto.Int32(receiverId)
+			pending.msg = msg
+			pending.addr = addr
+			pending.attempts = 0
+			pending.lastSend = time.Time{}
+			q.queues[newPeer] = append(q.queues[newPeer], pending)
+		}
+	}
+	return redirected
+}
+
+// Removes the messages addressed to the role, returns their keys
+func (q *retransmitQueue) discard(role protocol.NodeRole) []ackKey {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	discarded := make([]ackKey, 0)
+	for peer, queue := range q.queues {
+		for idx := len(queue) - 1; idx >= 0; idx-- {
+			if pending := queue[idx]; pending.receiverRole != nil && *pending.receiverRole == role {
+				q.removeAt(peer, idx)
+				discarded = append(discarded, pending.key())
+			}
+		}
+	}
+	return discarded
+}
+
+// Removes the messages to the peer, returns their keys
+func (q *retransmitQueue) discardPeer(addr *net.UDPAddr) []ackKey {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	discarded := make([]ackKey, 0)
+	for _, pending := range q.queues[addr.String()] {
+		discarded = append(discarded, pending.key())
+	}
+	delete(q.queues, addr.String())
+	return discarded
+}
+
+func (q *retransmitQueue) clear() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.queues = make(map[string][]*pendingMsg)
+}
+
+func (q *retransmitQueue) removeAt(peer string, idx int) {
+	queue := q.queues[peer]
+	q.queues[peer] = append(queue[:idx], queue[idx+1:]...)
+	if len(q.queues[peer]) == 0 {
+		delete(q.queues, peer)
+	}
+}
+
+func (p *Peer) retransmitMessages(ctx context.Context) {
+	defer p.wg.Done()
+
+	log.Logger.Debug("retransmitMessages goroutine is running")
+	for {
+		select {
+		case <-ctx.Done():
+			p.retransmitQueue.clear()
+			log.Logger.Debug("retransmitMessages goroutine has completed")
+			return
+		case now := <-time.After(retransmitPeriod):
+			resent, expired := p.retransmitQueue.due(now)
+			for _, pending := range resent {
+				p.sendProto(pending.msg, pending.addr)
+			}
+			for _, key := range expired {
+				p.correlator.fail(key)
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package p2p
+
+import (
+	"net"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"p2p-snake/internal/p2p/protocol"
+)
+
+var (
+	masterAddr = &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1}
+	deputyAddr = &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 2}
+	normalAddr = &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 3}
+)
+
+const testInterval = 100 * time.Millisecond
+
+// Message just sent for the first time, as sendProtoReliably queues it
+func newTestPendingMsg(seq int64, addr *net.UDPAddr, receiverRole *protocol.NodeRole, now time.Time) *pendingMsg {
+	return &pendingMsg{
+		msg:          protocol.NewPingMsg(seq, 10, 1),
+		addr:         addr,
+		receiverRole: receiverRole,
+		interval:     testInterval,
+		attempts:     1,
+		lastSend:     now,
+	}
+}
+
+// Keys of the messages left in the queue
+func queuedKeys(q *retransmitQueue) []ackKey {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	keys := make([]ackKey, 0)
+	for _, queue := range q.queues {
+		for _, pending := range queue {
+			keys = append(keys, pending.key())
+		}
+	}
+	sortKeys(keys)
+	return keys
+}
+
+func sortKeys(keys []ackKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].peer != keys[j].peer {
+			return keys[i].peer < keys[j].peer
+		}
+		return keys[i].msgSeq < keys[j].msgSeq
+	})
+}
+
+func TestRetransmitQueueAcknowledge(t *testing.T) {
+	tests := []struct {
+		name string
+		ack  ackKey
+		want []ackKey
+	}{
+		{
+			name: "acknowledged message is not resent",
+			ack:  newAckKey(masterAddr, 1),
+			want: []ackKey{newAckKey(masterAddr, 2), newAckKey(deputyAddr, 1)},
+		},
+		{
+			name: "acknowledgement from other peer is ignored",
+			ack:  newAckKey(normalAddr, 1),
+			want: []ackKey{newAckKey(masterAddr, 1), newAckKey(masterAddr, 2), newAckKey(deputyAddr, 1)},
+		},
+		{
+			name: "acknowledgement of unknown message is ignored",
+			ack:  newAckKey(masterAddr, 3),
+			want: []ackKey{newAckKey(masterAddr, 1), newAckKey(masterAddr, 2), newAckKey(deputyAddr, 1)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now := time.Now()
+			q := newRetransmitQueue()
+			q.push(newTestPendingMsg(1, masterAddr, nil, now))
+			q.push(newTestPendingMsg(2, masterAddr, nil, now))
+			q.push(newTestPendingMsg(1, deputyAddr, nil, now))
+
+			q.acknowledge(test.ack)
+			if got := queuedKeys(q); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("queued = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRetransmitQueueDue(t *testing.T) {
+	start := time.Now()
+	q := newRetransmitQueue()
+	q.push(newTestPendingMsg(1, masterAddr, nil, start))
+
+	if resent, expired := q.due(start.Add(testInterval / 2)); len(resent) != 0 || len(expired) != 0 {
+		t.Fatalf("due before the interval = %v, %v, want nothing", resent, expired)
+	}
+
+	// The message is sent maxSendAttempts times in total, then the waiter is failed
+	now := start
+	for attempt := int32(2); attempt <= maxSendAttempts; attempt++ {
+		now = now.Add(testInterval)
+		resent, expired := q.due(now)
+		if len(resent) != 1 || resent[0].attempts != attempt || len(expired) != 0 {
+			t.Fatalf("due on attempt %d = %v, %v, want the message resent", attempt, resent, expired)
+		}
+	}
+	now = now.Add(testInterval)
+	resent, expired := q.due(now)
+	if len(resent) != 0 || !reflect.DeepEqual(expired, []ackKey{newAckKey(masterAddr, 1)}) {
+		t.Fatalf("due after the last attempt = %v, %v, want the message expired", resent, expired)
+	}
+	if got := queuedKeys(q); len(got) != 0 {
+		t.Errorf("queued after the last attempt = %v, want nothing", got)
+	}
+}
+
+func TestRetransmitQueueRetarget(t *testing.T) {
+	now := time.Now()
+	q := newRetransmitQueue()
+	toMaster := newTestPendingMsg(1, masterAddr, protocol.NodeRole_MASTER.Enum(), now)
+	toMaster.attempts = 5
+	q.push(toMaster)
+	q.push(newTestPendingMsg(2, masterAddr, nil, now))
+	q.push(newTestPendingMsg(1, normalAddr, nil, now))
+	original := toMaster.msg
+
+	redirected := q.retarget(protocol.NodeRole_MASTER, 2, deputyAddr)
+	if want := []ackKey{newAckKey(masterAddr, 1)}; !reflect.DeepEqual(redirected, want) {
+		t.Errorf("redirected = %v, want %v", redirected, want)
+	}
+	want := []ackKey{newAckKey(masterAddr, 2), newAckKey(deputyAddr, 1), newAckKey(normalAddr, 1)}
+	if got := queuedKeys(q); !reflect.DeepEqual(got, want) {
+		t.Errorf("queued = %v, want %v", got, want)
+	}
+
+	// The redirected message is addressed to the new MASTER and is sent to it at once with all attempts
+	if toMaster.msg.GetReceiverId() != 2 || toMaster.attempts != 0 {
+		t.Errorf("redirected message to %d with %d attempts, want to 2 with 0", toMaster.msg.GetReceiverId(),
+			toMaster.attempts)
+	}
+	if original.GetReceiverId() != 1 {
+		t.Errorf("message being sent is changed: receiver %d, want 1", original.GetReceiverId())
+	}
+	if resent, _ := q.due(now); len(resent) != 1 || resent[0].key() != newAckKey(deputyAddr, 1) {
+		t.Errorf("resent = %v, want the redirected message", resent)
+	}
+
+	// The messages already sent to the new MASTER stay as they are
+	if redirected := q.retarget(protocol.NodeRole_MASTER, 2, deputyAddr); len(redirected) != 0 {
+		t.Errorf("redirected again = %v, want nothing", redirected)
+	}
+}
+
+func TestRetransmitQueueDiscard(t *testing.T) {
+	now := time.Now()
+	q := newRetransmitQueue()
+	q.push(newTestPendingMsg(1, masterAddr, protocol.NodeRole_MASTER.Enum(), now))
+	q.push(newTestPendingMsg(2, masterAddr, nil, now))
+	q.push(newTestPendingMsg(1, normalAddr, nil, now))
+	q.push(newTestPendingMsg(2, normalAddr, nil, now))
+
+	if discarded := q.discard(protocol.NodeRole_MASTER); !reflect.DeepEqual(discarded,
+		[]ackKey{newAckKey(masterAddr, 1)}) {
+		t.Errorf("discarded by role = %v, want message 1 to MASTER", discarded)
+	}
+
+	discarded := q.discardPeer(normalAddr)
+	sortKeys(discarded)
+	if want := []ackKey{newAckKey(normalAddr, 1), newAckKey(normalAddr, 2)}; !reflect.DeepEqual(discarded, want) {
+		t.Errorf("discarded by peer = %v, want %v", discarded, want)
+	}
+	if want := []ackKey{newAckKey(masterAddr, 2)}; !reflect.DeepEqual(queuedKeys(q), want) {
+		t.Errorf("queued = %v, want %v", queuedKeys(q), want)
+	}
+	if discarded := q.discardPeer(deputyAddr); len(discarded) != 0 {
+		t.Errorf("discarded for the peer without messages = %v, want nothing", discarded)
+	}
+}
@@ -11,14 +11,68 @@ type GameInfoDto struct {
 	Width      int32
 	Height     int32
 	StateDelay int32
+	Walled     bool
+	BotCount   int32
+	Teams      []TeamRosterDto
+	CanJoin    bool // The field has room for the snake of a new player
 }
 
-func NewGameInfoDto(name string, width int32, height int32, stateDelay int32) GameInfoDto {
+func NewGameInfoDto(name string, width int32, height int32, stateDelay int32, walled bool, botCount int32, teams []TeamRosterDto, canJoin bool) GameInfoDto {
 	return GameInfoDto{
 		Name:       name,
 		Width:      width,
 		Height:     height,
 		StateDelay: stateDelay,
+		Walled:     walled,
+		BotCount:   botCount,
+		Teams:      teams,
+		CanJoin:    canJoin,
+	}
+}
+
+//////// Team DTO ////////
+
+type TeamDto struct {
+	Id        int32
+	Score     int32
+	PlayerIds []int32
+}
+
+func NewTeamDto(id int32, score int32, playerIds []int32) TeamDto {
+	return TeamDto{
+		Id:        id,
+		Score:     score,
+		PlayerIds: playerIds,
+	}
+}
+
+type TeamRosterDto struct {
+	Id          int32
+	PlayerNames []string
+}
+
+func NewTeamRosterDto(id int32, playerNames []string) TeamRosterDto {
+	return TeamRosterDto{
+		Id:          id,
+		PlayerNames: playerNames,
+	}
+}
+
+//////// Map DTO ////////
+
+type MapDto struct {
+	Name   string
+	Width  int32
+	Height int32
+	Walled bool
+}
+
+func NewMapDto(name string, width int32, height int32, walled bool) MapDto {
+	return MapDto{
+		Name:   name,
+		Width:  width,
+		Height: height,
+		Walled: walled,
 	}
 }
 
@@ -29,14 +83,18 @@ type ConfigDto struct {
 	Height     int32
 	FoodStatic int32
 	StateDelay int32
+	Walled     bool
+	Walls      []CoordDto
 }
 
-func NewConfigDto(width int32, height int32, foodStatic int32, stateDelay int32) ConfigDto {
+func NewConfigDto(width int32, height int32, foodStatic int32, stateDelay int32, walled bool, walls []CoordDto) ConfigDto {
 	return ConfigDto{
 		Width:      width,
 		Height:     height,
 		FoodStatic: foodStatic,
 		StateDelay: stateDelay,
+		Walled:     walled,
+		Walls:      walls,
 	}
 }
 
@@ -69,13 +127,61 @@ type SnakeDto struct {
 	PlayerId      int32
 	HeadDirection Direction
 	Points        []CoordDto
+	Effects       []EffectDto
 }
 
-func NewSnakeDto(playerId int32, headDirection Direction, points []CoordDto) SnakeDto {
+func NewSnakeDto(playerId int32, headDirection Direction, points []CoordDto, effects []EffectDto) SnakeDto {
 	return SnakeDto{
 		PlayerId:      playerId,
 		HeadDirection: headDirection,
 		Points:        points,
+		Effects:       effects,
+	}
+}
+
+//////// Food DTO ////////
+
+type FoodKind int32
+
+const (
+	NORMAL_FOOD FoodKind = 1
+	GOLDEN_FOOD FoodKind = 2
+	POISON_FOOD FoodKind = 3
+)
+
+//////// Item DTO ////////
+
+type ItemKind int32
+
+const (
+	NO_ITEM          ItemKind = 0
+	SPEED_BOOST      ItemKind = 1
+	SHRINK           ItemKind = 2
+	GHOST            ItemKind = 3
+	SCORE_MULTIPLIER ItemKind = 4
+)
+
+type ItemDto struct {
+	Kind  ItemKind
+	Coord CoordDto
+}
+
+func NewItemDto(kind ItemKind, coord CoordDto) ItemDto {
+	return ItemDto{
+		Kind:  kind,
+		Coord: coord,
+	}
+}
+
+type EffectDto struct {
+	Kind           ItemKind
+	RemainingTurns int32
+}
+
+func NewEffectDto(kind ItemKind, remainingTurns int32) EffectDto {
+	return EffectDto{
+		Kind:           kind,
+		RemainingTurns: remainingTurns,
 	}
 }
 
@@ -95,14 +201,155 @@ type PlayerDto struct {
 	Id    int32
 	Score int32
 	Role  NodeRole
+	Team  int32 // 0 if the game has no teams
 }
 
-func NewPlayerDto(name string, id int32, score int32, role NodeRole) PlayerDto {
+func NewPlayerDto(name string, id int32, score int32, role NodeRole, team int32) PlayerDto {
 	return PlayerDto{
 		Name:  name,
 		Id:    id,
 		Score: score,
 		Role:  role,
+		Team:  team,
+	}
+}
+
+//////// Player stats DTO ////////
+
+type PlayerStatsDto struct {
+	PlayerId      int32
+	Name          string
+	Score         int32
+	FoodEaten     int32
+	Kills         int32
+	Deaths        int32
+	DeathCauses   map[DeathCause]int32
+	MaxLength     int32
+	TicksSurvived int32
+	Turns         int32
+}
+
+func NewPlayerStatsDto(playerId int32, name string, score int32, foodEaten int32, kills int32, deaths int32,
+	deathCauses map[DeathCause]int32, maxLength int32, ticksSurvived int32, turns int32) PlayerStatsDto {
+	return PlayerStatsDto{
+		PlayerId:      playerId,
+		Name:          name,
+		Score:         score,
+		FoodEaten:     foodEaten,
+		Kills:         kills,
+		Deaths:        deaths,
+		DeathCauses:   deathCauses,
+		MaxLength:     maxLength,
+		TicksSurvived: ticksSurvived,
+		Turns:         turns,
+	}
+}
+
+//////// Robot DTO ////////
+
+type RobotDto struct {
+	PlayerId int32
+	Name     string
+	Score    int32
+	Strategy string
+	IsAlive  bool
+}
+
+func NewRobotDto(playerId int32, name string, score int32, strategy string, isAlive bool) RobotDto {
+	return RobotDto{
+		PlayerId: playerId,
+		Name:     name,
+		Score:    score,
+		Strategy: strategy,
+		IsAlive:  isAlive,
+	}
+}
+
+//////// Event DTO ////////
+
+type EventType int32
+
+const (
+	FOOD_EATEN      EventType = 1
+	SNAKE_DIED      EventType = 2
+	POINTS_AWARDED  EventType = 3
+	FOOD_SPAWNED    EventType = 4
+	ZOMBIE_CREATED  EventType = 5
+	GAME_FINISHED   EventType = 6
+	ITEM_SPAWNED    EventType = 7
+	ITEM_PICKED_UP  EventType = 8
+	SNAKE_RESPAWNED EventType = 9
+)
+
+type DeathCause int32
+
+const (
+	NO_CAUSE DeathCause = 0
+	SELF     DeathCause = 1
+	SNAKE    DeathCause = 2
+	HEAD_ON  DeathCause = 3
+	WALL     DeathCause = 4
+)
+
+type EventDto struct {
+	Type      EventType
+	PlayerId  int32
+	Coord     CoordDto
+	Cause     DeathCause
+	KillerIds []int32
+	Points    int32
+	Item      ItemKind
+}
+
+func NewEventDto(eventType EventType, playerId int32, coord CoordDto, cause DeathCause, killerIds []int32, points int32, item ItemKind) EventDto {
+	return EventDto{
+		Type:      eventType,
+		PlayerId:  playerId,
+		Coord:     coord,
+		Cause:     cause,
+		KillerIds: killerIds,
+		Points:    points,
+		Item:      item,
+	}
+}
+
+//////// Result DTO ////////
+
+type FinishReason int32
+
+const (
+	TURN_LIMIT  FinishReason = 1
+	SCORE_LIMIT FinishReason = 2
+	LAST_SNAKE  FinishReason = 3
+)
+
+type StandingDto struct {
+	Place    int32
+	PlayerId int32
+	Name     string
+	Score    int32
+}
+
+func NewStandingDto(place int32, playerId int32, name string, score int32) StandingDto {
+	return StandingDto{
+		Place:    place,
+		PlayerId: playerId,
+		Name:     name,
+		Score:    score,
+	}
+}
+
+type ResultDto struct {
+	Reason    FinishReason
+	WinnerId  int32 // 0 if nobody won
+	Standings []StandingDto
+}
+
+func NewResultDto(reason FinishReason, winnerId int32, standings []StandingDto) *ResultDto {
+	return &ResultDto{
+		Reason:    reason,
+		WinnerId:  winnerId,
+		Standings: standings,
 	}
 }
 
@@ -113,15 +360,38 @@ type GameStateDto struct {
 	Config     ConfigDto
 	Snakes     []SnakeDto
 	Foods      []CoordDto
+	FoodKinds  []FoodKind // Kinds of the foods in the same order
+	Items      []ItemDto
 	Players    []PlayerDto
+	Events     []EventDto
+	Result     *ResultDto // nil if the game is not finished
+	Round      int32
+
+	// Shrinking arena
+	ArenaLevel      int32
+	NextShrinkOrder int32      // 0 if the arena does not shrink anymore
+	ArenaWalls      []CoordDto // Cells of the border rings that have become walls
+
+	Teams []TeamDto
 }
 
-func NewGameStateDto(stateOrder int32, config ConfigDto, snakes []SnakeDto, foods []CoordDto, players []PlayerDto) GameStateDto {
+func NewGameStateDto(stateOrder int32, config ConfigDto, snakes []SnakeDto, foods []CoordDto, foodKinds []FoodKind, items []ItemDto, players []PlayerDto, events []EventDto, result *ResultDto, round int32, arenaLevel int32, nextShrinkOrder int32, arenaWalls []CoordDto, teams []TeamDto) GameStateDto {
 	return GameStateDto{
 		StateOrder: stateOrder,
 		Config:     config,
 		Snakes:     snakes,
 		Foods:      foods,
+		FoodKinds:  foodKinds,
+		Items:      items,
 		Players:    players,
+		Events:     events,
+		Result:     result,
+		Round:      round,
+
+		ArenaLevel:      arenaLevel,
+		NextShrinkOrder: nextShrinkOrder,
+		ArenaWalls:      arenaWalls,
+
+		Teams: teams,
 	}
 }
@@ -1,6 +1,10 @@
 package dto
 
-import "p2p-snake/internal/p2p/protocol"
+import (
+	"sort"
+
+	"p2p-snake/internal/p2p/protocol"
+)
 
 func toConfigDto(config *protocol.GameConfig) ConfigDto {
 	return NewConfigDto(
@@ -8,17 +12,67 @@ func toConfigDto(config *protocol.GameConfig) ConfigDto {
 		config.GetHeight(),
 		config.GetFoodStatic(),
 		config.GetStateDelayMs(),
+		config.GetWalled(),
+		toCoordDtos(config.GetWalls()),
 	)
 }
 
-func toSnakeDto(snake *protocol.GameState_Snake) SnakeDto {
+func toSnakeDto(snake *protocol.GameState_Snake, stateOrder int32) SnakeDto {
 	return NewSnakeDto(
 		snake.GetPlayerId(),
 		toDirection(snake.GetHeadDirection()),
 		toCoordDtos(snake.GetPoints()),
+		toEffectDtos(snake.GetEffects(), stateOrder),
 	)
 }
 
+// Food without a kind is normal
+func toFoodKinds(kinds []protocol.GameState_FoodKind, foodCount int) []FoodKind {
+	foodKinds := make([]FoodKind, foodCount)
+	for i := range foodKinds {
+		foodKinds[i] = NORMAL_FOOD
+		if i < len(kinds) {
+			switch kinds[i] {
+			case protocol.GameState_GOLDEN_FOOD:
+				foodKinds[i] = GOLDEN_FOOD
+			case protocol.GameState_POISON_FOOD:
+				foodKinds[i] = POISON_FOOD
+			}
+		}
+	}
+	return foodKinds
+}
+
+func toItemKind(kind protocol.GameState_Item_Kind) ItemKind {
+	switch kind {
+	case protocol.GameState_Item_SPEED_BOOST:
+		return SPEED_BOOST
+	case protocol.GameState_Item_SHRINK:
+		return SHRINK
+	case protocol.GameState_Item_GHOST:
+		return GHOST
+	case protocol.GameState_Item_SCORE_MULTIPLIER:
+		return SCORE_MULTIPLIER
+	}
+	return NO_ITEM
+}
+
+func toItemDtos(items []*protocol.GameState_Item) []ItemDto {
+	itemDtos := make([]ItemDto, len(items))
+	for i, item := range items {
+		itemDtos[i] = NewItemDto(toItemKind(item.GetKind()), NewCoordDto(item.GetCoord()))
+	}
+	return itemDtos
+}
+
+func toEffectDtos(effects []*protocol.GameState_Effect, stateOrder int32) []EffectDto {
+	effectDtos := make([]EffectDto, len(effects))
+	for i, effect := range effects {
+		effectDtos[i] = NewEffectDto(toItemKind(effect.GetKind()), effect.GetDeadline()-stateOrder+1)
+	}
+	return effectDtos
+}
+
 func toDirection(direction protocol.Direction) Direction {
 	switch direction {
 	case protocol.Direction_UP:
@@ -55,10 +109,10 @@ func toCoordDtos(coords []*protocol.GameState_Coord) []CoordDto {
 	return coordDtos
 }
 
-func toSnakeDtos(snakes []*protocol.GameState_Snake) []SnakeDto {
+func toSnakeDtos(snakes []*protocol.GameState_Snake, stateOrder int32) []SnakeDto {
 	snakeDtos := make([]SnakeDto, len(snakes))
 	for i, snake := range snakes {
-		snakeDtos[i] = toSnakeDto(snake)
+		snakeDtos[i] = toSnakeDto(snake, stateOrder)
 	}
 	return snakeDtos
 }
@@ -69,6 +123,7 @@ func toPlayerDto(player *protocol.GamePlayer) PlayerDto {
 		player.GetId(),
 		player.GetScore(),
 		toRole(player.GetRole()),
+		player.GetTeam(),
 	)
 }
 
@@ -80,17 +135,217 @@ func toPlayerDtos(players *protocol.GamePlayers) []PlayerDto {
 	return playerDtos
 }
 
+func toTeamDtos(teams []*protocol.Team) []TeamDto {
+	teamDtos := make([]TeamDto, len(teams))
+	for i, team := range teams {
+		teamDtos[i] = NewTeamDto(team.GetId(), team.GetScore(), team.GetPlayerIds())
+	}
+	return teamDtos
+}
+
+func ToTeamRosterDtos(teams []*protocol.Team, players *protocol.GamePlayers) []TeamRosterDto {
+	names := make(map[int32]string)
+	for _, player := range players.GetPlayers() {
+		names[player.GetId()] = player.GetName()
+	}
+
+	rosterDtos := make([]TeamRosterDto, len(teams))
+	for i, team := range teams {
+		playerNames := make([]string, 0, len(team.GetPlayerIds()))
+		for _, playerId := range team.GetPlayerIds() {
+			playerNames = append(playerNames, names[playerId])
+		}
+		rosterDtos[i] = NewTeamRosterDto(team.GetId(), playerNames)
+	}
+	return rosterDtos
+}
+
+func toEventType(eventType protocol.GameEvent_EventType) EventType {
+	switch eventType {
+	case protocol.GameEvent_FOOD_EATEN:
+		return FOOD_EATEN
+	case protocol.GameEvent_SNAKE_DIED:
+		return SNAKE_DIED
+	case protocol.GameEvent_POINTS_AWARDED:
+		return POINTS_AWARDED
+	case protocol.GameEvent_FOOD_SPAWNED:
+		return FOOD_SPAWNED
+	case protocol.GameEvent_ZOMBIE_CREATED:
+		return ZOMBIE_CREATED
+	case protocol.GameEvent_GAME_FINISHED:
+		return GAME_FINISHED
+	case protocol.GameEvent_ITEM_SPAWNED:
+		return ITEM_SPAWNED
+	case protocol.GameEvent_ITEM_PICKED_UP:
+		return ITEM_PICKED_UP
+	case protocol.GameEvent_SNAKE_RESPAWNED:
+		return SNAKE_RESPAWNED
+	}
+	return 0
+}
+
+func toDeathCause(event *protocol.GameEvent) DeathCause {
+	if event.Cause == nil {
+		return NO_CAUSE
+	}
+	return toDeathCauseValue(event.GetCause())
+}
+
+func toDeathCauseValue(cause protocol.GameEvent_DeathCause) DeathCause {
+	switch cause {
+	case protocol.GameEvent_SELF:
+		return SELF
+	case protocol.GameEvent_SNAKE:
+		return SNAKE
+	case protocol.GameEvent_HEAD_ON:
+		return HEAD_ON
+	case protocol.GameEvent_WALL:
+		return WALL
+	}
+	return NO_CAUSE
+}
+
+func toEventItemKind(event *protocol.GameEvent) ItemKind {
+	if event.Item == nil {
+		return NO_ITEM
+	}
+	return toItemKind(event.GetItem())
+}
+
+func toEventDto(event *protocol.GameEvent) EventDto {
+	return NewEventDto(
+		toEventType(event.GetType()),
+		event.GetPlayerId(),
+		NewCoordDto(event.GetCoord()),
+		toDeathCause(event),
+		event.GetKillerIds(),
+		event.GetPoints(),
+		toEventItemKind(event),
+	)
+}
+
+func toEventDtos(events []*protocol.GameEvent) []EventDto {
+	eventDtos := make([]EventDto, len(events))
+	for i, event := range events {
+		eventDtos[i] = toEventDto(event)
+	}
+	return eventDtos
+}
+
+func toFinishReason(reason protocol.GameResult_FinishReason) FinishReason {
+	switch reason {
+	case protocol.GameResult_TURN_LIMIT:
+		return TURN_LIMIT
+	case protocol.GameResult_SCORE_LIMIT:
+		return SCORE_LIMIT
+	case protocol.GameResult_LAST_SNAKE:
+		return LAST_SNAKE
+	}
+	return 0
+}
+
+func toStandingDtos(players *protocol.GamePlayers) []StandingDto {
+	sortedPlayers := make([]*protocol.GamePlayer, len(players.GetPlayers()))
+	copy(sortedPlayers, players.GetPlayers())
+	sort.SliceStable(sortedPlayers, func(i, j int) bool {
+		if sortedPlayers[i].GetScore() != sortedPlayers[j].GetScore() {
+			return sortedPlayers[i].GetScore() > sortedPlayers[j].GetScore()
+		}
+		return sortedPlayers[i].GetId() < sortedPlayers[j].GetId()
+	})
+
+	// Players with the same score share the place
+	standingDtos := make([]StandingDto, len(sortedPlayers))
+	for i, player := range sortedPlayers {
+		place := int32(i + 1)
+		if i > 0 && player.GetScore() == sortedPlayers[i-1].GetScore() {
+			place = standingDtos[i-1].Place
+		}
+		standingDtos[i] = NewStandingDto(place, player.GetId(), player.GetName(), player.GetScore())
+	}
+	return standingDtos
+}
+
+func toResultDto(result *protocol.GameResult, players *protocol.GamePlayers) *ResultDto {
+	if result == nil {
+		return nil
+	}
+	return NewResultDto(
+		toFinishReason(result.GetReason()),
+		result.GetWinnerId(),
+		toStandingDtos(players),
+	)
+}
+
 func ToGameStateDto(
 	stateOrder int32,
 	config *protocol.GameConfig,
 	snakes []*protocol.GameState_Snake,
 	foods []*protocol.GameState_Coord,
-	players *protocol.GamePlayers) GameStateDto {
+	foodKinds []protocol.GameState_FoodKind,
+	items []*protocol.GameState_Item,
+	players *protocol.GamePlayers,
+	events []*protocol.GameEvent,
+	result *protocol.GameResult,
+	round int32,
+	arenaLevel int32,
+	nextShrinkOrder int32,
+	arenaWalls []*protocol.GameState_Coord,
+	teams []*protocol.Team) GameStateDto {
 	return NewGameStateDto(
 		stateOrder,
 		toConfigDto(config),
-		toSnakeDtos(snakes),
+		toSnakeDtos(snakes, stateOrder),
 		toCoordDtos(foods),
+		toFoodKinds(foodKinds, len(foods)),
+		toItemDtos(items),
 		toPlayerDtos(players),
+		toEventDtos(events),
+		toResultDto(result, players),
+		round,
+		arenaLevel,
+		nextShrinkOrder,
+		toCoordDtos(arenaWalls),
+		toTeamDtos(teams),
 	)
 }
+
+func ToRobotDto(player *protocol.GamePlayer, strategy string) RobotDto {
+	return NewRobotDto(
+		player.GetId(),
+		player.GetName(),
+		player.GetScore(),
+		strategy,
+		player.GetRole() != protocol.NodeRole_VIEWER,
+	)
+}
+
+func toPlayerStatsDto(player *protocol.GamePlayer) PlayerStatsDto {
+	stats := player.GetStats()
+	deathCauses := make(map[DeathCause]int32)
+	for _, deathCount := range stats.GetDeathCauses() {
+		deathCauses[toDeathCauseValue(deathCount.GetCause())] += deathCount.GetCount()
+	}
+
+	return NewPlayerStatsDto(
+		player.GetId(),
+		player.GetName(),
+		player.GetScore(),
+		stats.GetFoodEaten(),
+		stats.GetKills(),
+		stats.GetDeaths(),
+		deathCauses,
+		stats.GetMaxLength(),
+		stats.GetTicksSurvived(),
+		stats.GetTurns(),
+	)
+}
+
+func ToPlayerStatsDtos(players *protocol.GamePlayers) []PlayerStatsDto {
+	statsDtos := make([]PlayerStatsDto, len(players.GetPlayers()))
+	for i, player := range players.GetPlayers() {
+		statsDtos[i] = toPlayerStatsDto(player)
+	}
+	sort.Slice(statsDtos, func(i, j int) bool { return statsDtos[i].PlayerId < statsDtos[j].PlayerId })
+	return statsDtos
+}
@@ -1,12 +1,17 @@
 package game
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
 	"p2p-snake/internal/engine"
 	"p2p-snake/internal/p2p/protocol"
 )
@@ -14,11 +19,23 @@ import (
 var (
 	nextPlayerId int32 = 1
 
-	notValidWidthError        = fmt.Errorf("width should be from 10 to 100")
-	notValidHeightError       = fmt.Errorf("height should be from 10 to 100")
-	notValidFoodStaticError   = fmt.Errorf("initial amount of foods should be from 0 to 100")
-	notValidStateDelayError   = fmt.Errorf("state delay should be from 100 to 3000")
-	gameIsNotInitializedError = fmt.Errorf("game is not initialized")
+	notValidWidthError         = fmt.Errorf("width should be from 10 to 100")
+	notValidHeightError        = fmt.Errorf("height should be from 10 to 100")
+	notValidFoodStaticError    = fmt.Errorf("initial amount of foods should be from 0 to 100")
+	notValidStateDelayError    = fmt.Errorf("state delay should be from 100 to 3000")
+	notValidWallError          = fmt.Errorf("walls should be inside the field")
+	notValidSpawnPointError    = fmt.Errorf("spawn points should be inside the field")
+	notValidFoodSpotError      = fmt.Errorf("food spots should be inside the field")
+	notValidMaxTurnsError      = fmt.Errorf("game duration should not be negative")
+	notValidTargetScoreError   = fmt.Errorf("target score should not be negative")
+	notValidMaxItemsError      = fmt.Errorf("max items should be from 0 to 100")
+	notValidFoodWeightError    = fmt.Errorf("food weights should be from 0 to 100")
+	notValidRespawnDelayError  = fmt.Errorf("respawn delay should not be negative")
+	notValidShrinkError        = fmt.Errorf("shrink interval should not be negative")
+	notValidTeamCountError     = fmt.Errorf("team count should be from 0 to 10")
+	notValidTeamError          = fmt.Errorf("team should be one of the teams of the game")
+	notValidSpawnDistanceError = fmt.Errorf("spawn distance should be from 0 to 20")
+	gameIsNotInitializedError  = fmt.Errorf("game is not initialized")
 )
 
 type GameInfo struct {
@@ -28,6 +45,7 @@ type GameInfo struct {
 	stateDelay  time.Duration
 	game        *engine.Game
 	nodes       map[int32]*NodeInfo
+	events      []*protocol.GameEvent
 
 	// Player moves
 	moves map[int32]engine.Direction
@@ -43,6 +61,7 @@ func NewGameInfo() *GameInfo {
 		stateDelay:  -1,
 		game:        nil,
 		nodes:       make(map[int32]*NodeInfo),
+		events:      make([]*protocol.GameEvent, 0),
 
 		moves: make(map[int32]engine.Direction),
 
@@ -87,7 +106,7 @@ func (i *GameInfo) Width() int32 {
 }
 
 func (i *GameInfo) SetWidth(width int32) {
-	i.game.Width = width
+	i.game.SetSize(width, i.game.Height)
 }
 
 func (i *GameInfo) Height() int32 {
@@ -95,7 +114,7 @@ func (i *GameInfo) Height() int32 {
 }
 
 func (i *GameInfo) SetHeight(height int32) {
-	i.game.Height = height
+	i.game.SetSize(i.game.Width, height)
 }
 
 func (i *GameInfo) FoodStatic() int32 {
@@ -106,13 +125,221 @@ func (i *GameInfo) SetFoodStatic(foodStatic int32) {
 	i.game.FoodStatic = foodStatic
 }
 
+func (i *GameInfo) Walled() bool {
+	return i.game.Walled
+}
+
+func (i *GameInfo) Walls() []*protocol.GameState_Coord {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return toCoords(i.game.Walls)
+}
+
+func (i *GameInfo) SetWalls(walled bool, walls []*protocol.GameState_Coord) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.SetWalls(walled, toEngineCoords(walls))
+}
+
+func (i *GameInfo) SpawnPoints() []*protocol.GameState_Coord {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return toCoords(i.game.SpawnPoints)
+}
+
+func (i *GameInfo) FoodSpots() []*protocol.GameState_Coord {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return toCoords(i.game.FoodSpots)
+}
+
+func (i *GameInfo) SetSpawnDistance(spawnDistance int32) error {
+	if spawnDistance < 0 || spawnDistance > 20 {
+		return notValidSpawnDistanceError
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.SetSpawnDistance(spawnDistance)
+	return nil
+}
+
+// Checks that the field has room for the snake of a new player
+func (i *GameInfo) CanJoin() bool {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.game.CanSpawn()
+}
+
+func (i *GameInfo) Seed() int64 {
+	return i.game.Seed
+}
+
+func (i *GameInfo) SetReclaimTimeout(reclaimTimeout time.Duration) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.ReclaimTurns = int32(reclaimTimeout / i.stateDelay)
+}
+
+func (i *GameInfo) SetEndConditions(maxTurns int32, targetScore int32, lastSnakeStanding bool) error {
+	if maxTurns < 0 {
+		return notValidMaxTurnsError
+	}
+	if targetScore < 0 {
+		return notValidTargetScoreError
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.MaxTurns = maxTurns
+	i.game.TargetScore = targetScore
+	i.game.LastSnakeStanding = lastSnakeStanding
+	return nil
+}
+
+func (i *GameInfo) SetMaxItems(maxItems int32) error {
+	if maxItems < 0 || maxItems > 100 {
+		return notValidMaxItemsError
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.MaxItems = maxItems
+	return nil
+}
+
+func (i *GameInfo) SetFoodWeights(normalWeight int32, goldenWeight int32, poisonWeight int32) error {
+	for _, weight := range []int32{normalWeight, goldenWeight, poisonWeight} {
+		if weight < 0 || weight > 100 {
+			return notValidFoodWeightError
+		}
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.SetFoodWeights(map[engine.FoodKind]int32{
+		engine.NORMAL_FOOD: normalWeight,
+		engine.GOLDEN_FOOD: goldenWeight,
+		engine.POISON_FOOD: poisonWeight,
+	})
+	return nil
+}
+
+func (i *GameInfo) SetRespawn(respawnDelay int32, halveScore bool) error {
+	if respawnDelay < 0 {
+		return notValidRespawnDelayError
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.SetRespawn(respawnDelay, halveScore)
+	return nil
+}
+
+func (i *GameInfo) RespawnEnabled() bool {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.game.RespawnTurns > 0
+}
+
+func (i *GameInfo) SetShrinkInterval(shrinkInterval int32) error {
+	if shrinkInterval < 0 {
+		return notValidShrinkError
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.SetShrinkInterval(shrinkInterval)
+	return nil
+}
+
+func (i *GameInfo) ArenaLevel() int32 {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.game.ArenaLevel
+}
+
+func (i *GameInfo) NextShrinkOrder() int32 {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.game.NextShrinkTurn
+}
+
+func (i *GameInfo) ArenaWalls() []*protocol.GameState_Coord {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return toCoords(i.game.ArenaWalls())
+}
+
+func (i *GameInfo) SetArena(arenaLevel int32, nextShrinkOrder int32) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.SetArena(arenaLevel, nextShrinkOrder)
+}
+
+func (i *GameInfo) SetTeamRules(teamCount int32, safeTeammates bool) error {
+	if teamCount < 0 || teamCount > 10 {
+		return notValidTeamCountError
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.SetTeams(teamCount, safeTeammates)
+	return nil
+}
+
+func (i *GameInfo) Teams() []*protocol.Team {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return toTeams(i.game)
+}
+
+func (i *GameInfo) SetTeams(teams []*protocol.Team) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.SetTeamScores(toEngineTeamScores(teams))
+}
+
+func (i *GameInfo) Result() *protocol.GameResult {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return toResult(i.game)
+}
+
+func (i *GameInfo) SetResult(result *protocol.GameResult) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.Finish = toEngineFinishReason(result)
+	i.game.WinnerId = result.GetWinnerId()
+}
+
+func (i *GameInfo) IsFinished() bool {
+	return i.Result() != nil
+}
+
+func (i *GameInfo) Round() int32 {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.game.Round
+}
+
+func (i *GameInfo) RoundStartOrder() int32 {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.game.RoundStartTurn
+}
+
+func (i *GameInfo) SetRound(round int32, roundStartOrder int32) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.Round = round
+	i.game.RoundStartTurn = roundStartOrder
+}
+
 func (i *GameInfo) Config() *protocol.GameConfig {
-	return toConfig(
-		i.Width(),
-		i.Height(),
-		i.FoodStatic(),
-		i.StateDelay(),
-	)
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return toConfig(i.game, i.StateDelay())
 }
 
 func (i *GameInfo) SetConfig(config *protocol.GameConfig) {
@@ -120,6 +347,21 @@ func (i *GameInfo) SetConfig(config *protocol.GameConfig) {
 	i.SetHeight(config.GetHeight())
 	i.SetFoodStatic(config.GetFoodStatic())
 	i.SetStateDelay(time.Duration(config.GetStateDelayMs()) * time.Millisecond)
+	i.SetWalls(config.GetWalled(), config.GetWalls())
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.SetSpawnPoints(toEngineCoords(config.GetSpawnPoints()))
+	i.game.SetFoodSpots(toEngineCoords(config.GetFoodSpots()))
+	i.game.MaxTurns = config.GetMaxTurns()
+	i.game.TargetScore = config.GetTargetScore()
+	i.game.LastSnakeStanding = config.GetLastSnakeStanding()
+	i.game.MaxItems = config.GetMaxItems()
+	i.game.SetFoodWeights(toEngineFoodWeights(config))
+	i.game.SetRespawn(config.GetRespawnDelay(), config.GetHalveScoreOnRespawn())
+	i.game.ShrinkInterval = config.GetShrinkInterval()
+	i.game.SetTeams(config.GetTeamCount(), config.GetSafeTeammates())
+	i.game.SetSpawnDistance(config.GetSpawnDistance())
 }
 
 func (i *GameInfo) Snakes() []*protocol.GameState_Snake {
@@ -128,22 +370,47 @@ func (i *GameInfo) Snakes() []*protocol.GameState_Snake {
 	return toSnakes(i.game.Snakes)
 }
 
+// Returns a copy of the game the robots look at without holding the lock
+func (i *GameInfo) Snapshot() *engine.Game {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.game.Clone()
+}
+
 func (i *GameInfo) SetSnakes(snakes []*protocol.GameState_Snake) {
 	i.lock.Lock()
 	defer i.lock.Unlock()
-	i.game.Snakes = toEngineSnakes(snakes)
+	i.game.SetSnakes(toEngineSnakes(snakes))
 }
 
 func (i *GameInfo) Foods() []*protocol.GameState_Coord {
 	i.lock.RLock()
 	defer i.lock.RUnlock()
-	return toCoords(i.game.Foods)
+	return toFoodCoords(i.game.Foods)
 }
 
-func (i *GameInfo) SetFoods(foods []*protocol.GameState_Coord) {
+func (i *GameInfo) FoodKinds() []protocol.GameState_FoodKind {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return toFoodKinds(i.game.Foods)
+}
+
+func (i *GameInfo) SetFoods(foods []*protocol.GameState_Coord, kinds []protocol.GameState_FoodKind) {
 	i.lock.Lock()
 	defer i.lock.Unlock()
-	i.game.Foods = toEngineCoords(foods)
+	i.game.SetFoods(toEngineFoods(foods, kinds))
+}
+
+func (i *GameInfo) Items() []*protocol.GameState_Item {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return toItems(i.game.Items)
+}
+
+func (i *GameInfo) SetItems(items []*protocol.GameState_Item) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.game.SetItems(toEngineItems(items))
 }
 
 func (i *GameInfo) Players() *protocol.GamePlayers {
@@ -158,16 +425,30 @@ func (i *GameInfo) SetPlayers(players *protocol.GamePlayers) {
 	i.game.Players = toEnginePlayers(players)
 }
 
+func (i *GameInfo) Events() []*protocol.GameEvent {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.events
+}
+
+func (i *GameInfo) SetEvents(events []*protocol.GameEvent) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.events = events
+}
+
 func (i *GameInfo) Nodes() map[int32]*NodeInfo {
 	i.lock.RLock()
 	defer i.lock.RUnlock()
 	return i.nodes
 }
 
+// Replaces the nodes with the players of the state, so that the old MASTER that has left is forgotten
 func (i *GameInfo) SetNodes(players *protocol.GamePlayers) {
-	for playerId, node := range toNodeInfos(players) {
-		i.SetNode(playerId, node)
-	}
+	nodes := toNodeInfos(players)
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.nodes = nodes
 }
 
 func (i *GameInfo) Node(playerId int32) (*NodeInfo, bool) {
@@ -186,13 +467,23 @@ func (i *GameInfo) SetNode(playerId int32, node *NodeInfo) {
 func (i *GameInfo) NormalNodes() []*NodeInfo {
 	normals := make([]*NodeInfo, 0)
 	for _, node := range i.Nodes() {
-		if node.Role() == protocol.NodeRole_NORMAL {
+		if node.Role() == protocol.NodeRole_NORMAL && !node.IsLocalRobotNode() {
 			normals = append(normals, node)
 		}
 	}
 	return normals
 }
 
+func (i *GameInfo) RobotNodes() []*NodeInfo {
+	robots := make([]*NodeInfo, 0)
+	for _, node := range i.Nodes() {
+		if node.IsLocalRobotNode() {
+			robots = append(robots, node)
+		}
+	}
+	return robots
+}
+
 func (i *GameInfo) MasterNode() *NodeInfo {
 	for _, node := range i.Nodes() {
 		if node.Role() == protocol.NodeRole_MASTER {
@@ -238,49 +529,130 @@ func (i *GameInfo) ExistsPlayerByAddr(addr *net.UDPAddr) bool {
 	return false
 }
 
-func (i *GameInfo) CreateNewGame(gameName string, width int32, height int32, foodStatic int32, stateDelay int32) error {
+// Creates the game by the config, the values out of the allowed ranges are refused
+func (i *GameInfo) CreateNewGame(gameName string, config *protocol.GameConfig) error {
 	// Check
+	width, height := config.GetWidth(), config.GetHeight()
 	if width < 10 || width > 100 {
 		return notValidWidthError
 	}
 	if height < 10 || height > 100 {
 		return notValidHeightError
 	}
-	if foodStatic < 0 || foodStatic > 100 {
+	if config.GetFoodStatic() < 0 || config.GetFoodStatic() > 100 {
 		return notValidFoodStaticError
 	}
-	if stateDelay < 100 || stateDelay > 3000 {
+	if config.GetStateDelayMs() < 100 || config.GetStateDelayMs() > 3000 {
 		return notValidStateDelayError
 	}
+	if !isInsideField(config.GetWalls(), width, height) {
+		return notValidWallError
+	}
+	if !isInsideField(config.GetSpawnPoints(), width, height) {
+		return notValidSpawnPointError
+	}
+	if !isInsideField(config.GetFoodSpots(), width, height) {
+		return notValidFoodSpotError
+	}
 
 	i.lock.Lock()
-	defer i.lock.Unlock()
+	i.game = engine.NewGame(gameName, width, height, config.GetFoodStatic(), time.Now().UnixNano())
+	i.game.SetWalls(config.GetWalled(), toEngineCoords(config.GetWalls()))
+	i.game.SetSpawnPoints(toEngineCoords(config.GetSpawnPoints()))
+	i.game.SetFoodSpots(toEngineCoords(config.GetFoodSpots()))
+	i.SetStateDelay(time.Duration(config.GetStateDelayMs()) * time.Millisecond)
+	i.lock.Unlock()
 
-	i.game = engine.NewGame(gameName, width, height, foodStatic)
-	i.SetStateDelay(time.Duration(stateDelay) * time.Millisecond)
-	return nil
+	// Rules
+	if err := i.SetEndConditions(config.GetMaxTurns(), config.GetTargetScore(), config.GetLastSnakeStanding()); err != nil {
+		return err
+	}
+	if err := i.SetMaxItems(config.GetMaxItems()); err != nil {
+		return err
+	}
+	if err := i.SetFoodWeights(config.GetNormalFoodWeight(), config.GetGoldenFoodWeight(),
+		config.GetPoisonFoodWeight()); err != nil {
+		return err
+	}
+	if err := i.SetRespawn(config.GetRespawnDelay(), config.GetHalveScoreOnRespawn()); err != nil {
+		return err
+	}
+	if err := i.SetShrinkInterval(config.GetShrinkInterval()); err != nil {
+		return err
+	}
+	if err := i.SetTeamRules(config.GetTeamCount(), config.GetSafeTeammates()); err != nil {
+		return err
+	}
+	return i.SetSpawnDistance(config.GetSpawnDistance())
 }
 
-func (i *GameInfo) AddPlayer(playerName string, role protocol.NodeRole, addr *net.UDPAddr) (*NodeInfo, error) {
+// Adds the player to the team (if it is 0, the team is chosen automatically)
+func (i *GameInfo) AddPlayer(playerName string, playerType protocol.PlayerType, role protocol.NodeRole, team int32, addr *net.UDPAddr, reconnectToken string) (*NodeInfo, string, error) {
 	if i.game == nil {
-		return nil, gameIsNotInitializedError
+		return nil, "", gameIsNotInitializedError
 	}
 
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
+	if team < 0 || team > i.game.TeamCount {
+		return nil, "", notValidTeamError
+	}
+
+	// Give the zombie snake back to the returning player
+	if reconnectToken != "" && role != protocol.NodeRole_VIEWER {
+		if playerId, ok := i.game.ReclaimSnake(playerName, hashToken(reconnectToken)); ok {
+			node := NewNodeInfo(playerId, playerType, role, addr)
+			i.nodes[playerId] = node
+			return node, reconnectToken, nil
+		}
+	}
+
 	// Add player
-	err := i.game.AddPlayer(nextPlayerId, playerName, role != protocol.NodeRole_VIEWER)
+	reconnectToken = uuid.NewString()
+	err := i.game.AddPlayer(nextPlayerId, playerName, hashToken(reconnectToken), team, role != protocol.NodeRole_VIEWER)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Create node
-	node := NewNodeInfo(nextPlayerId, role, addr)
+	node := NewNodeInfo(nextPlayerId, playerType, role, addr)
 	i.nodes[nextPlayerId] = node
 	nextPlayerId = nextPlayerId + 1
 
-	return node, nil
+	return node, reconnectToken, nil
+}
+
+// Adds all robots or, if there is no room for some of them, none
+func (i *GameInfo) AddRobots(count int32) ([]*NodeInfo, error) {
+	if i.game == nil {
+		return nil, gameIsNotInitializedError
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	// The robots already added are removed from the same game, so the random stream of the game is kept
+	firstPlayerId := nextPlayerId
+	robots := make([]*NodeInfo, 0, count)
+	for k := int32(0); k < count; k++ {
+		err := i.game.AddPlayer(nextPlayerId, fmt.Sprintf("Robot %d", nextPlayerId), "", 0, true)
+		if err != nil {
+			for _, robot := range robots {
+				i.game.RemovePlayer(robot.PlayerId())
+			}
+			nextPlayerId = firstPlayerId
+			return nil, err
+		}
+		robots = append(robots, NewNodeInfo(nextPlayerId, protocol.PlayerType_ROBOT, protocol.NodeRole_NORMAL, nil))
+		nextPlayerId = nextPlayerId + 1
+	}
+
+	// Create nodes without address, the robots are driven by the MASTER node
+	for _, robot := range robots {
+		i.nodes[robot.PlayerId()] = robot
+	}
+	return robots, nil
 }
 
 func (i *GameInfo) DeletePlayer(playerId int32) error {
@@ -292,7 +664,20 @@ func (i *GameInfo) DeletePlayer(playerId int32) error {
 	defer i.lock.Unlock()
 
 	delete(i.nodes, playerId)
-	delete(i.game.Players, playerId)
+	i.game.DeletePlayer(playerId)
+	return nil
+}
+
+func (i *GameInfo) RemovePlayer(playerId int32) error {
+	if i.game == nil {
+		return gameIsNotInitializedError
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	delete(i.nodes, playerId)
+	i.game.RemovePlayer(playerId)
 	return nil
 }
 
@@ -304,17 +689,54 @@ func (i *GameInfo) AddMove(playerId int32, direction protocol.Direction) error {
 	return nil
 }
 
-func (i *GameInfo) GenerateNextState() ([]int32, error) {
+// Starts a new round, the players who got their snakes back become NORMAL nodes again
+func (i *GameInfo) RestartRound(keepScores bool) error {
+	if i.game == nil {
+		return gameIsNotInitializedError
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.game.RestartRound(keepScores)
+	i.events = make([]*protocol.GameEvent, 0)
+	i.moves = make(map[int32]engine.Direction)
+	return nil
+}
+
+func (i *GameInfo) GenerateNextState() ([]*protocol.GameEvent, error) {
 	if i.game == nil {
-		return []int32{}, gameIsNotInitializedError
+		return []*protocol.GameEvent{}, gameIsNotInitializedError
 	}
 
+	// The engine random source is not safe for concurrent use
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
 	// Generate next state
-	deadSnakes := i.game.NextState(i.moves)
+	i.events = toEvents(i.game.NextState(i.moves))
 	i.stateOrder.Add(1)
 	i.moves = make(map[int32]engine.Direction)
 
-	return deadSnakes, nil
+	return i.events, nil
+}
+
+func (i *GameInfo) State() *protocol.GameState {
+	return &protocol.GameState{
+		StateOrder:      proto.Int32(i.StateOrder()),
+		Snakes:          i.Snakes(),
+		Foods:           i.Foods(),
+		Players:         i.Players(),
+		Events:          i.Events(),
+		Result:          i.Result(),
+		Round:           proto.Int32(i.Round()),
+		RoundStartOrder: proto.Int32(i.RoundStartOrder()),
+		Items:           i.Items(),
+		FoodKinds:       i.FoodKinds(),
+		ArenaLevel:      proto.Int32(i.ArenaLevel()),
+		NextShrinkOrder: proto.Int32(i.NextShrinkOrder()),
+		Teams:           i.Teams(),
+	}
 }
 
 func (i *GameInfo) SetState(currentPlayerId int32, state *protocol.GameState, addr *net.UDPAddr) {
@@ -322,22 +744,48 @@ func (i *GameInfo) SetState(currentPlayerId int32, state *protocol.GameState, ad
 	i.SetNodes(state.GetPlayers())
 	i.SetPlayers(state.GetPlayers())
 	i.SetSnakes(state.GetSnakes())
-	i.SetFoods(state.GetFoods())
+	i.SetFoods(state.GetFoods(), state.GetFoodKinds())
+	i.SetItems(state.GetItems())
+	i.SetEvents(state.GetEvents())
+	i.SetResult(state.GetResult())
+	i.SetRound(state.GetRound(), state.GetRoundStartOrder())
+	i.SetArena(state.GetArenaLevel(), state.GetNextShrinkOrder())
+	i.SetTeams(state.GetTeams())
 
+	i.lock.Lock()
+	i.game.Turn = state.GetStateOrder()
+	i.lock.Unlock()
+
+	// Zombie snakes keep the identifiers of the players who left
 	for _, player := range state.GetPlayers().GetPlayers() {
 		if player.GetId() >= nextPlayerId {
 			nextPlayerId = player.GetId() + 1
 		}
 	}
-
-	if master := i.MasterNode(); master == nil {
-		if deputy := i.DeputyNode(); deputy != nil {
-			deputy.SetRole(protocol.NodeRole_MASTER)
+	for _, snake := range state.GetSnakes() {
+		if snake.GetPlayerId() >= nextPlayerId {
+			nextPlayerId = snake.GetPlayerId() + 1
 		}
 	}
 
 	currentNode, _ := i.Node(currentPlayerId)
 	i.SetCurrentNode(currentNode)
 
-	i.MasterNode().SetAddr(addr)
+	if master := i.MasterNode(); master != nil {
+		master.SetAddr(addr)
+	}
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func isInsideField(coords []*protocol.GameState_Coord, width int32, height int32) bool {
+	for _, coord := range coords {
+		if coord.GetX() < 0 || coord.GetX() >= width || coord.GetY() < 0 || coord.GetY() >= height {
+			return false
+		}
+	}
+	return true
 }
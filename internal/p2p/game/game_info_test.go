@@ -0,0 +1,62 @@
+package game
+
+import (
+	"sort"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"p2p-snake/internal/p2p/protocol"
+)
+
+func TestAddRobotsAllOrNone(t *testing.T) {
+	info := NewGameInfo()
+	if err := info.CreateNewGame("robots", &protocol.GameConfig{
+		Width:        proto.Int32(30),
+		Height:       proto.Int32(30),
+		StateDelayMs: proto.Int32(1000),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := info.AddRobots(2); err != nil {
+		t.Fatal(err)
+	}
+	state := sortedState(info)
+	nodes := len(info.Nodes())
+
+	// The field 30x30 has no room for 100 snakes
+	if robots, err := info.AddRobots(100); err == nil {
+		t.Fatalf("%d robots are added, want none", len(robots))
+	}
+	if got := sortedState(info); !proto.Equal(got, state) {
+		t.Errorf("state after the failed batch = %v, want %v", got, state)
+	}
+	if got := len(info.Nodes()); got != nodes {
+		t.Errorf("%d nodes after the failed batch, want %d", got, nodes)
+	}
+
+	// The next robot gets the id following the last added one
+	robots, err := info.AddRobots(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastId := int32(0)
+	for _, player := range state.GetPlayers().GetPlayers() {
+		if player.GetType() == protocol.PlayerType_ROBOT && player.GetId() > lastId {
+			lastId = player.GetId()
+		}
+	}
+	if robots[0].PlayerId() != lastId+1 {
+		t.Errorf("robot id = %d, want %d", robots[0].PlayerId(), lastId+1)
+	}
+}
+
+// State with the players and snakes in the order of their ids
+func sortedState(info *GameInfo) *protocol.GameState {
+	state := info.State()
+	players := state.GetPlayers().GetPlayers()
+	sort.Slice(players, func(i, j int) bool { return players[i].GetId() < players[j].GetId() })
+	snakes := state.GetSnakes()
+	sort.Slice(snakes, func(i, j int) bool { return snakes[i].GetPlayerId() < snakes[j].GetPlayerId() })
+	return state
+}
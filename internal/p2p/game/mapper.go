@@ -3,6 +3,7 @@ package game
 import (
 	"fmt"
 	"net"
+	"sort"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -13,12 +14,36 @@ import (
 
 //////// ENGINE -> P2P ////////
 
-func toConfig(width int32, height int32, foodStatic int32, stateDelay time.Duration) *protocol.GameConfig {
+func toConfig(game *engine.Game, stateDelay time.Duration) *protocol.GameConfig {
 	return &protocol.GameConfig{
-		Width:        proto.Int32(width),
-		Height:       proto.Int32(height),
-		FoodStatic:   proto.Int32(foodStatic),
+		Width:        proto.Int32(game.Width),
+		Height:       proto.Int32(game.Height),
+		FoodStatic:   proto.Int32(game.FoodStatic),
 		StateDelayMs: proto.Int32(int32(stateDelay / time.Millisecond)),
+		Walled:       proto.Bool(game.Walled),
+		Walls:        toCoords(game.Walls),
+		SpawnPoints:  toCoords(game.SpawnPoints),
+		FoodSpots:    toCoords(game.FoodSpots),
+
+		MaxTurns:          proto.Int32(game.MaxTurns),
+		TargetScore:       proto.Int32(game.TargetScore),
+		LastSnakeStanding: proto.Bool(game.LastSnakeStanding),
+
+		MaxItems: proto.Int32(game.MaxItems),
+
+		NormalFoodWeight: proto.Int32(game.FoodWeights[engine.NORMAL_FOOD]),
+		GoldenFoodWeight: proto.Int32(game.FoodWeights[engine.GOLDEN_FOOD]),
+		PoisonFoodWeight: proto.Int32(game.FoodWeights[engine.POISON_FOOD]),
+
+		RespawnDelay:        proto.Int32(game.RespawnTurns),
+		HalveScoreOnRespawn: proto.Bool(game.HalveScoreOnRespawn),
+
+		ShrinkInterval: proto.Int32(game.ShrinkInterval),
+
+		TeamCount:     proto.Int32(game.TeamCount),
+		SafeTeammates: proto.Bool(game.SafeTeammates),
+
+		SpawnDistance: proto.Int32(game.SpawnDistance),
 	}
 }
 
@@ -37,6 +62,70 @@ func toCoords(engineCoords []engine.Coord) []*protocol.GameState_Coord {
 	return coords
 }
 
+func toFoodKind(kind engine.FoodKind) protocol.GameState_FoodKind {
+	switch kind {
+	case engine.NORMAL_FOOD:
+		return protocol.GameState_NORMAL_FOOD
+	case engine.GOLDEN_FOOD:
+		return protocol.GameState_GOLDEN_FOOD
+	case engine.POISON_FOOD:
+		return protocol.GameState_POISON_FOOD
+	}
+	return -1
+}
+
+func toFoodCoords(engineFoods []engine.Food) []*protocol.GameState_Coord {
+	coords := make([]*protocol.GameState_Coord, len(engineFoods))
+	for i, engineFood := range engineFoods {
+		coords[i] = toCoord(engineFood.Coord)
+	}
+	return coords
+}
+
+func toFoodKinds(engineFoods []engine.Food) []protocol.GameState_FoodKind {
+	kinds := make([]protocol.GameState_FoodKind, len(engineFoods))
+	for i, engineFood := range engineFoods {
+		kinds[i] = toFoodKind(engineFood.Kind)
+	}
+	return kinds
+}
+
+func toItemKind(kind engine.ItemKind) protocol.GameState_Item_Kind {
+	switch kind {
+	case engine.SPEED_BOOST:
+		return protocol.GameState_Item_SPEED_BOOST
+	case engine.SHRINK:
+		return protocol.GameState_Item_SHRINK
+	case engine.GHOST:
+		return protocol.GameState_Item_GHOST
+	case engine.SCORE_MULTIPLIER:
+		return protocol.GameState_Item_SCORE_MULTIPLIER
+	}
+	return -1
+}
+
+func toItems(engineItems []engine.Item) []*protocol.GameState_Item {
+	items := make([]*protocol.GameState_Item, len(engineItems))
+	for i, engineItem := range engineItems {
+		items[i] = &protocol.GameState_Item{
+			Kind:  toItemKind(engineItem.Kind).Enum(),
+			Coord: toCoord(engineItem.Coord),
+		}
+	}
+	return items
+}
+
+func toEffects(engineEffects []engine.Effect) []*protocol.GameState_Effect {
+	effects := make([]*protocol.GameState_Effect, len(engineEffects))
+	for i, engineEffect := range engineEffects {
+		effects[i] = &protocol.GameState_Effect{
+			Kind:     toItemKind(engineEffect.Kind).Enum(),
+			Deadline: proto.Int32(engineEffect.Deadline),
+		}
+	}
+	return effects
+}
+
 func toSnakeState(isZombie bool) protocol.GameState_Snake_SnakeState {
 	if isZombie {
 		return protocol.GameState_Snake_ZOMBIE
@@ -63,12 +152,29 @@ func toSnake(snake *engine.Snake) *protocol.GameState_Snake {
 	state := toSnakeState(snake.IsZombie)
 	headDirection := toDirection(snake.HeadDirection)
 
-	return &protocol.GameState_Snake{
+	gameSnake := &protocol.GameState_Snake{
 		PlayerId:      proto.Int32(snake.PlayerId),
 		State:         state.Enum(),
 		HeadDirection: headDirection.Enum(),
 		Points:        coords,
+		Effects:       toEffects(snake.Effects),
+	}
+	if snake.Growth > 0 {
+		gameSnake.Growth = proto.Int32(snake.Growth)
 	}
+	if snake.Owner != nil {
+		gameSnake.OwnerName = proto.String(snake.Owner.Name)
+		gameSnake.OwnerScore = proto.Int32(snake.Owner.Score)
+		gameSnake.OwnerTokenHash = proto.String(snake.Owner.TokenHash)
+		gameSnake.ReclaimDeadline = proto.Int32(snake.Owner.Deadline)
+		if snake.Owner.Team != 0 {
+			gameSnake.OwnerTeam = proto.Int32(snake.Owner.Team)
+		}
+		if snake.Owner.Stats != nil {
+			gameSnake.OwnerStats = toPlayerStats(snake.Owner.Stats)
+		}
+	}
+	return gameSnake
 }
 
 func toSnakes(engineSnakes map[int32]*engine.Snake) []*protocol.GameState_Snake {
@@ -87,14 +193,68 @@ func toPlayer(enginePlayer *engine.Player, nodeInfo *NodeInfo) *protocol.GamePla
 		port = proto.Int32(int32(nodeInfo.addr.Port))
 	}
 
+	var tokenHash *string = nil
+	if enginePlayer.TokenHash != "" {
+		tokenHash = proto.String(enginePlayer.TokenHash)
+	}
+
+	var spectator *bool = nil
+	if enginePlayer.Spectator {
+		spectator = proto.Bool(true)
+	}
+
+	var respawnOrder *int32 = nil
+	if enginePlayer.RespawnTurn != 0 {
+		respawnOrder = proto.Int32(enginePlayer.RespawnTurn)
+	}
+
+	var team *int32 = nil
+	if enginePlayer.Team != 0 {
+		team = proto.Int32(enginePlayer.Team)
+	}
+
 	return &protocol.GamePlayer{
-		Name:      proto.String(enginePlayer.Name),
-		Id:        proto.Int32(enginePlayer.Id),
-		IpAddress: ip,
-		Port:      port,
-		Role:      nodeInfo.role.Enum(),
-		Type:      protocol.Default_GamePlayer_Type.Enum(),
-		Score:     proto.Int32(enginePlayer.Score),
+		Name:               proto.String(enginePlayer.Name),
+		Id:                 proto.Int32(enginePlayer.Id),
+		IpAddress:          ip,
+		Port:               port,
+		Role:               nodeInfo.role.Enum(),
+		Type:               nodeInfo.playerType.Enum(),
+		Score:              proto.Int32(enginePlayer.Score),
+		ReconnectTokenHash: tokenHash,
+		Spectator:          spectator,
+		Stats:              toPlayerStats(enginePlayer.Stats),
+		RespawnOrder:       respawnOrder,
+		Team:               team,
+	}
+}
+
+func toPlayerStats(engineStats *engine.PlayerStats) *protocol.PlayerStats {
+	// Death causes are sorted to keep the message stable
+	causes := make([]engine.DeathCause, 0, len(engineStats.DeathCauses))
+	for cause := range engineStats.DeathCauses {
+		causes = append(causes, cause)
+	}
+	sort.Slice(causes, func(i, j int) bool { return causes[i] < causes[j] })
+
+	deathCauses := make([]*protocol.PlayerStats_DeathCount, 0, len(causes))
+	for _, cause := range causes {
+		if protocolCause := toDeathCause(cause); protocolCause != nil {
+			deathCauses = append(deathCauses, &protocol.PlayerStats_DeathCount{
+				Cause: protocolCause,
+				Count: proto.Int32(engineStats.DeathCauses[cause]),
+			})
+		}
+	}
+
+	return &protocol.PlayerStats{
+		FoodEaten:     proto.Int32(engineStats.FoodEaten),
+		Kills:         proto.Int32(engineStats.Kills),
+		Deaths:        proto.Int32(engineStats.Deaths),
+		DeathCauses:   deathCauses,
+		MaxLength:     proto.Int32(engineStats.MaxLength),
+		TicksSurvived: proto.Int32(engineStats.TicksSurvived),
+		Turns:         proto.Int32(engineStats.Turns),
 	}
 }
 
@@ -108,8 +268,128 @@ func toPlayers(enginePlayers map[int32]*engine.Player, nodeInfos map[int32]*Node
 	}
 }
 
+func toEventType(eventType engine.EventType) protocol.GameEvent_EventType {
+	switch eventType {
+	case engine.FOOD_EATEN:
+		return protocol.GameEvent_FOOD_EATEN
+	case engine.SNAKE_DIED:
+		return protocol.GameEvent_SNAKE_DIED
+	case engine.POINTS_AWARDED:
+		return protocol.GameEvent_POINTS_AWARDED
+	case engine.FOOD_SPAWNED:
+		return protocol.GameEvent_FOOD_SPAWNED
+	case engine.ZOMBIE_CREATED:
+		return protocol.GameEvent_ZOMBIE_CREATED
+	case engine.GAME_FINISHED:
+		return protocol.GameEvent_GAME_FINISHED
+	case engine.ITEM_SPAWNED:
+		return protocol.GameEvent_ITEM_SPAWNED
+	case engine.ITEM_PICKED_UP:
+		return protocol.GameEvent_ITEM_PICKED_UP
+	case engine.SNAKE_RESPAWNED:
+		return protocol.GameEvent_SNAKE_RESPAWNED
+	}
+	return -1
+}
+
+func toDeathCause(cause engine.DeathCause) *protocol.GameEvent_DeathCause {
+	switch cause {
+	case engine.SELF:
+		return protocol.GameEvent_SELF.Enum()
+	case engine.SNAKE:
+		return protocol.GameEvent_SNAKE.Enum()
+	case engine.HEAD_ON:
+		return protocol.GameEvent_HEAD_ON.Enum()
+	case engine.WALL:
+		return protocol.GameEvent_WALL.Enum()
+	}
+	return nil
+}
+
+func toEvent(engineEvent engine.Event) *protocol.GameEvent {
+	event := &protocol.GameEvent{
+		Type:      toEventType(engineEvent.Type).Enum(),
+		Coord:     toCoord(engineEvent.Coord),
+		Cause:     toDeathCause(engineEvent.Cause),
+		KillerIds: engineEvent.KillerIds,
+	}
+	if engineEvent.Type != engine.FOOD_SPAWNED && engineEvent.Type != engine.ITEM_SPAWNED &&
+		(engineEvent.Type != engine.GAME_FINISHED || engineEvent.PlayerId != 0) {
+		event.PlayerId = proto.Int32(engineEvent.PlayerId)
+	}
+	if engineEvent.Type == engine.POINTS_AWARDED {
+		event.Points = proto.Int32(engineEvent.Points)
+	}
+	if engineEvent.Type == engine.ITEM_SPAWNED || engineEvent.Type == engine.ITEM_PICKED_UP {
+		event.Item = toItemKind(engineEvent.Item).Enum()
+	}
+	return event
+}
+
+func toEvents(engineEvents []engine.Event) []*protocol.GameEvent {
+	events := make([]*protocol.GameEvent, len(engineEvents))
+	for i, engineEvent := range engineEvents {
+		events[i] = toEvent(engineEvent)
+	}
+	return events
+}
+
+func toFinishReason(reason engine.FinishReason) protocol.GameResult_FinishReason {
+	switch reason {
+	case engine.TURN_LIMIT:
+		return protocol.GameResult_TURN_LIMIT
+	case engine.SCORE_LIMIT:
+		return protocol.GameResult_SCORE_LIMIT
+	case engine.LAST_SNAKE:
+		return protocol.GameResult_LAST_SNAKE
+	}
+	return -1
+}
+
+func toResult(game *engine.Game) *protocol.GameResult {
+	if game.Finish == engine.NOT_FINISHED {
+		return nil
+	}
+
+	result := &protocol.GameResult{
+		Reason: toFinishReason(game.Finish).Enum(),
+	}
+	if game.WinnerId != 0 {
+		result.WinnerId = proto.Int32(game.WinnerId)
+	}
+	return result
+}
+
+func toTeams(game *engine.Game) []*protocol.Team {
+	rosters := game.TeamRosters()
+	teams := make([]*protocol.Team, 0, len(rosters))
+	for team := int32(1); team <= game.TeamCount; team++ {
+		teams = append(teams, &protocol.Team{
+			Id:        proto.Int32(team),
+			Score:     proto.Int32(game.TeamScores[team]),
+			PlayerIds: rosters[team],
+		})
+	}
+	return teams
+}
+
 //////// P2P -> ENGINE ////////
 
+func toEngineFinishReason(result *protocol.GameResult) engine.FinishReason {
+	if result == nil {
+		return engine.NOT_FINISHED
+	}
+	switch result.GetReason() {
+	case protocol.GameResult_TURN_LIMIT:
+		return engine.TURN_LIMIT
+	case protocol.GameResult_SCORE_LIMIT:
+		return engine.SCORE_LIMIT
+	case protocol.GameResult_LAST_SNAKE:
+		return engine.LAST_SNAKE
+	}
+	return engine.NOT_FINISHED
+}
+
 func toEngineCoord(coord *protocol.GameState_Coord) engine.Coord {
 	return engine.NewCoord(coord.GetX(), coord.GetY())
 }
@@ -122,6 +402,81 @@ func toEngineCoords(coords []*protocol.GameState_Coord) []engine.Coord {
 	return engineCoords
 }
 
+func toEngineDeathCause(cause protocol.GameEvent_DeathCause) engine.DeathCause {
+	switch cause {
+	case protocol.GameEvent_SELF:
+		return engine.SELF
+	case protocol.GameEvent_SNAKE:
+		return engine.SNAKE
+	case protocol.GameEvent_HEAD_ON:
+		return engine.HEAD_ON
+	case protocol.GameEvent_WALL:
+		return engine.WALL
+	}
+	return engine.NO_CAUSE
+}
+
+func toEngineFoodKind(kind protocol.GameState_FoodKind) engine.FoodKind {
+	switch kind {
+	case protocol.GameState_GOLDEN_FOOD:
+		return engine.GOLDEN_FOOD
+	case protocol.GameState_POISON_FOOD:
+		return engine.POISON_FOOD
+	}
+	return engine.NORMAL_FOOD
+}
+
+// Food without a kind (sent by the peers that do not know food kinds) is normal
+func toEngineFoods(coords []*protocol.GameState_Coord, kinds []protocol.GameState_FoodKind) []engine.Food {
+	engineFoods := make([]engine.Food, len(coords))
+	for i, coord := range coords {
+		kind := engine.NORMAL_FOOD
+		if i < len(kinds) {
+			kind = toEngineFoodKind(kinds[i])
+		}
+		engineFoods[i] = engine.NewFood(kind, toEngineCoord(coord))
+	}
+	return engineFoods
+}
+
+func toEngineFoodWeights(config *protocol.GameConfig) map[engine.FoodKind]int32 {
+	return map[engine.FoodKind]int32{
+		engine.NORMAL_FOOD: config.GetNormalFoodWeight(),
+		engine.GOLDEN_FOOD: config.GetGoldenFoodWeight(),
+		engine.POISON_FOOD: config.GetPoisonFoodWeight(),
+	}
+}
+
+func toEngineItemKind(kind protocol.GameState_Item_Kind) engine.ItemKind {
+	switch kind {
+	case protocol.GameState_Item_SPEED_BOOST:
+		return engine.SPEED_BOOST
+	case protocol.GameState_Item_SHRINK:
+		return engine.SHRINK
+	case protocol.GameState_Item_GHOST:
+		return engine.GHOST
+	case protocol.GameState_Item_SCORE_MULTIPLIER:
+		return engine.SCORE_MULTIPLIER
+	}
+	return -1
+}
+
+func toEngineItems(items []*protocol.GameState_Item) []engine.Item {
+	engineItems := make([]engine.Item, len(items))
+	for i, item := range items {
+		engineItems[i] = engine.NewItem(toEngineItemKind(item.GetKind()), toEngineCoord(item.GetCoord()))
+	}
+	return engineItems
+}
+
+func toEngineEffects(effects []*protocol.GameState_Effect) []engine.Effect {
+	engineEffects := make([]engine.Effect, len(effects))
+	for i, effect := range effects {
+		engineEffects[i] = engine.NewEffect(toEngineItemKind(effect.GetKind()), effect.GetDeadline())
+	}
+	return engineEffects
+}
+
 func toEngineSnakeState(state protocol.GameState_Snake_SnakeState) bool {
 	return state == protocol.GameState_Snake_ZOMBIE
 }
@@ -146,13 +501,25 @@ func toEngineSnake(snake *protocol.GameState_Snake) *engine.Snake {
 	state := toEngineSnakeState(snake.GetState())
 	headDirection := toEngineDirection(snake.GetHeadDirection())
 
-	return engine.NewSnake(
+	engineSnake := engine.NewSnake(
 		playerId,
 		coords,
 		state,
 		headDirection,
-		false,
+		snake.GetGrowth(),
 	)
+	engineSnake.Effects = toEngineEffects(snake.GetEffects())
+	if snake.OwnerTokenHash != nil {
+		engineSnake.Owner = engine.NewZombieOwner(
+			snake.GetOwnerName(),
+			snake.GetOwnerScore(),
+			snake.GetOwnerTokenHash(),
+			snake.GetReclaimDeadline(),
+			snake.GetOwnerTeam(),
+			toEnginePlayerStats(snake.GetOwnerStats()),
+		)
+	}
+	return engineSnake
 }
 
 func toEngineSnakes(snakes []*protocol.GameState_Snake) map[int32]*engine.Snake {
@@ -163,12 +530,32 @@ func toEngineSnakes(snakes []*protocol.GameState_Snake) map[int32]*engine.Snake
 	return engineSnakes
 }
 
+func toEnginePlayerStats(stats *protocol.PlayerStats) *engine.PlayerStats {
+	engineStats := engine.NewPlayerStats()
+	engineStats.FoodEaten = stats.GetFoodEaten()
+	engineStats.Kills = stats.GetKills()
+	engineStats.Deaths = stats.GetDeaths()
+	for _, deathCount := range stats.GetDeathCauses() {
+		engineStats.DeathCauses[toEngineDeathCause(deathCount.GetCause())] += deathCount.GetCount()
+	}
+	engineStats.MaxLength = stats.GetMaxLength()
+	engineStats.TicksSurvived = stats.GetTicksSurvived()
+	engineStats.Turns = stats.GetTurns()
+	return engineStats
+}
+
 func toEnginePlayer(gamePlayer *protocol.GamePlayer) *engine.Player {
-	return engine.NewPlayer(
+	player := engine.NewPlayer(
 		gamePlayer.GetId(),
 		gamePlayer.GetName(),
 		gamePlayer.GetScore(),
+		gamePlayer.GetReconnectTokenHash(),
+		gamePlayer.GetSpectator(),
 	)
+	player.Stats = toEnginePlayerStats(gamePlayer.GetStats())
+	player.RespawnTurn = gamePlayer.GetRespawnOrder()
+	player.Team = gamePlayer.GetTeam()
+	return player
 }
 
 func toEnginePlayers(gamePlayers *protocol.GamePlayers) map[int32]*engine.Player {
@@ -179,6 +566,14 @@ func toEnginePlayers(gamePlayers *protocol.GamePlayers) map[int32]*engine.Player
 	return players
 }
 
+func toEngineTeamScores(teams []*protocol.Team) map[int32]int32 {
+	teamScores := make(map[int32]int32)
+	for _, team := range teams {
+		teamScores[team.GetId()] = team.GetScore()
+	}
+	return teamScores
+}
+
 func toNodeInfo(gamePlayer *protocol.GamePlayer) *NodeInfo {
 	var addr *net.UDPAddr
 	if gamePlayer.IpAddress == nil && gamePlayer.Port == nil {
@@ -190,6 +585,7 @@ func toNodeInfo(gamePlayer *protocol.GamePlayer) *NodeInfo {
 
 	return NewNodeInfo(
 		gamePlayer.GetId(),
+		gamePlayer.GetType(),
 		gamePlayer.GetRole(),
 		addr,
 	)
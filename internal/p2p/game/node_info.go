@@ -9,20 +9,22 @@ import (
 )
 
 type NodeInfo struct {
-	playerId int32
-	role     protocol.NodeRole
-	addr     *net.UDPAddr
+	playerId   int32
+	playerType protocol.PlayerType
+	role       protocol.NodeRole
+	addr       *net.UDPAddr
 
 	lastUpdate time.Time
 
 	lock *sync.RWMutex
 }
 
-func NewNodeInfo(playerId int32, role protocol.NodeRole, addr *net.UDPAddr) *NodeInfo {
+func NewNodeInfo(playerId int32, playerType protocol.PlayerType, role protocol.NodeRole, addr *net.UDPAddr) *NodeInfo {
 	return &NodeInfo{
-		playerId: playerId,
-		role:     role,
-		addr:     addr,
+		playerId:   playerId,
+		playerType: playerType,
+		role:       role,
+		addr:       addr,
 
 		lastUpdate: time.Now(),
 
@@ -34,6 +36,10 @@ func (n *NodeInfo) PlayerId() int32 {
 	return n.playerId
 }
 
+func (n *NodeInfo) PlayerType() protocol.PlayerType {
+	return n.playerType
+}
+
 func (n *NodeInfo) Role() protocol.NodeRole {
 	n.lock.RLock()
 	defer n.lock.RUnlock()
@@ -91,3 +97,8 @@ func (n *NodeInfo) IsNormalNode() bool {
 func (n *NodeInfo) IsViewerNode() bool {
 	return n.Role() == protocol.NodeRole_VIEWER
 }
+
+// Robot driven by the MASTER node itself (it has no address)
+func (n *NodeInfo) IsLocalRobotNode() bool {
+	return n.playerType == protocol.PlayerType_ROBOT && n.Addr() == nil
+}
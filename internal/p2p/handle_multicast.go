@@ -6,6 +6,7 @@ import (
 
 	"p2p-snake/internal/log"
 	"p2p-snake/internal/p2p/announcements"
+	"p2p-snake/internal/p2p/dto"
 	"p2p-snake/internal/p2p/protocol"
 )
 
@@ -45,14 +46,24 @@ func (p *Peer) handleAnnouncementMsg(announcementMsg *protocol.GameMessage_Annou
 		announcements.NewAnnouncement(
 			addr,
 			announcementMsg.GetGames()[0].GetGameName(),
-			announcementMsg.GetGames()[0].GetConfig().GetWidth(),
-			announcementMsg.GetGames()[0].GetConfig().GetHeight(),
-			announcementMsg.GetGames()[0].GetConfig().GetFoodStatic(),
-			announcementMsg.GetGames()[0].GetConfig().GetStateDelayMs(),
+			announcementMsg.GetGames()[0].GetConfig(),
+			countBots(announcementMsg.GetGames()[0].GetPlayers()),
+			dto.ToTeamRosterDtos(announcementMsg.GetGames()[0].GetTeams(), announcementMsg.GetGames()[0].GetPlayers()),
+			announcementMsg.GetGames()[0].GetCanJoin(),
 		),
 	)
 }
 
+func countBots(players *protocol.GamePlayers) int32 {
+	botCount := int32(0)
+	for _, player := range players.GetPlayers() {
+		if player.GetType() == protocol.PlayerType_ROBOT {
+			botCount++
+		}
+	}
+	return botCount
+}
+
 func (p *Peer) handleDiscoverMsg() {
 	if p.gameInfo != nil && p.gameInfo.CurrentNode().IsMasterNode() {
 		p.sendAnnouncementMsg(p.gameInfo, p.multicastAddr)
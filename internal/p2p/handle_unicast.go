@@ -5,6 +5,7 @@ import (
 	"net"
 
 	"p2p-snake/internal/log"
+	"p2p-snake/internal/p2p/game"
 	"p2p-snake/internal/p2p/protocol"
 )
 
@@ -17,6 +18,7 @@ const (
 	senderIsViewerError      = "sender is viewer"
 	duplicatePlayerNameError = "player with such name already exists"
 	duplicatePlayerAddrError = "player with such address already exists"
+	illegalRoleChangeError   = "illegal role change"
 )
 
 func (p *Peer) listenUnicast(ctx context.Context) {
@@ -58,28 +60,34 @@ func (p *Peer) handleUnicastMsg(gameMsg *protocol.GameMessage, addr *net.UDPAddr
 }
 
 func (p *Peer) handleAckMsg(msg *protocol.GameMessage, addr *net.UDPAddr) {
-	if p.gameInfo != nil && p.gameInfo.CurrentNode().PlayerId() != msg.GetReceiverId() {
+	// The node waiting for the response may leave the game as soon as it gets it
+	gameInfo := p.gameInfo
+	if gameInfo != nil && gameInfo.CurrentNode().PlayerId() != msg.GetReceiverId() {
 		return
 	}
-	if response, ok := p.notAckMsg[msg.GetMsgSeq()]; ok {
-		response <- msg
+	key := newAckKey(addr, msg.GetMsgSeq())
+	if p.correlator.resolve(key, msg) {
+		p.retransmitQueue.acknowledge(key)
 	}
-	if p.gameInfo != nil {
-		if node, ok := p.gameInfo.Node(msg.GetSenderId()); ok {
+	if gameInfo != nil {
+		if node, ok := gameInfo.Node(msg.GetSenderId()); ok {
 			node.UpdateTimeAsNow()
 		}
 	}
 }
 
 func (p *Peer) handleErrorMsg(msg *protocol.GameMessage, addr *net.UDPAddr) {
-	if p.gameInfo != nil && p.gameInfo.CurrentNode().PlayerId() != msg.GetReceiverId() {
+	// The node waiting for the response may leave the game as soon as it gets it
+	gameInfo := p.gameInfo
+	if gameInfo != nil && gameInfo.CurrentNode().PlayerId() != msg.GetReceiverId() {
 		return
 	}
-	if response, ok := p.notAckMsg[msg.GetMsgSeq()]; ok {
-		response <- msg
+	key := newAckKey(addr, msg.GetMsgSeq())
+	if p.correlator.resolve(key, msg) {
+		p.retransmitQueue.acknowledge(key)
 	}
-	if p.gameInfo != nil {
-		if node, ok := p.gameInfo.Node(msg.GetSenderId()); ok {
+	if gameInfo != nil {
+		if node, ok := gameInfo.Node(msg.GetSenderId()); ok {
 			node.UpdateTimeAsNow()
 		}
 	}
@@ -98,6 +106,16 @@ func (p *Peer) handleJoinMsg(msg *protocol.GameMessage, addr *net.UDPAddr) {
 		p.sendErrorMsg(msg.GetMsgSeq(), msg.GetReceiverId(), msg.GetSenderId(), gameNameNotMatchError, addr)
 		return
 	}
+
+	// The resent JoinMsg gets the same acknowledgement as the first one
+	p.joinsLock.Lock()
+	defer p.joinsLock.Unlock()
+	key := newAckKey(addr, msg.GetMsgSeq())
+	if join, ok := p.joins[key]; ok {
+		p.sendJoinAckMsg(msg.GetMsgSeq(), p.gameInfo.CurrentNode().PlayerId(), join.playerId, join.reconnectToken, addr)
+		return
+	}
+
 	if p.gameInfo.ExistsPlayerByName(msg.GetJoin().GetPlayerName()) {
 		p.sendErrorMsg(msg.GetMsgSeq(), msg.GetReceiverId(), msg.GetSenderId(), duplicatePlayerNameError, addr)
 		return
@@ -107,16 +125,25 @@ func (p *Peer) handleJoinMsg(msg *protocol.GameMessage, addr *net.UDPAddr) {
 		return
 	}
 
-	node, err := p.gameInfo.AddPlayer(msg.GetJoin().GetPlayerName(), msg.GetJoin().GetRequestedRole(), addr)
+	node, reconnectToken, err := p.gameInfo.AddPlayer(
+		msg.GetJoin().GetPlayerName(),
+		msg.GetJoin().GetPlayerType(),
+		msg.GetJoin().GetRequestedRole(),
+		msg.GetJoin().GetTeam(),
+		addr,
+		msg.GetJoin().GetReconnectToken(),
+	)
 	if err != nil {
 		p.sendErrorMsg(msg.GetMsgSeq(), msg.GetReceiverId(), msg.GetSenderId(), err.Error(), addr)
 		return
 	}
+	p.joins[key] = joinAck{playerId: node.PlayerId(), reconnectToken: reconnectToken}
 
-	p.sendAckMsg(
+	p.sendJoinAckMsg(
 		msg.GetMsgSeq(),
 		p.gameInfo.CurrentNode().PlayerId(),
 		node.PlayerId(),
+		reconnectToken,
 		addr,
 	)
 }
@@ -142,13 +169,37 @@ func (p *Peer) handleRoleChangeMsg(msg *protocol.GameMessage, addr *net.UDPAddr)
 	if p.gameInfo.CurrentNode().PlayerId() != msg.GetReceiverId() {
 		return
 	}
-	if msg.GetRoleChange().SenderRole != nil {
-		if node, ok := p.gameInfo.Node(msg.GetSenderId()); ok && msg.GetRoleChange().GetSenderRole() != protocol.NodeRole_MASTER {
-			node.SetRole(msg.GetRoleChange().GetSenderRole())
+	// The resent message asks for the role the node already has
+	roleChange := msg.GetRoleChange()
+	changeOwnRole := roleChange.ReceiverRole != nil && roleChange.GetReceiverRole() != p.gameInfo.CurrentNode().Role()
+	ownTrigger := receiverRoleTriggers[roleChange.GetReceiverRole()]
+	if _, err := nextRole(p.gameInfo.CurrentNode().Role(), ownTrigger); changeOwnRole && err != nil {
+		p.sendErrorMsg(msg.GetMsgSeq(), msg.GetReceiverId(), msg.GetSenderId(), illegalRoleChangeError, addr)
+		return
+	}
+
+	var leaving *game.NodeInfo
+	if node, ok := p.gameInfo.Node(msg.GetSenderId()); ok && roleChange.SenderRole != nil {
+		switch roleChange.GetSenderRole() {
+		case protocol.NodeRole_MASTER:
+			// DEPUTY has become MASTER
+			if !node.IsMasterNode() && !p.gameInfo.CurrentNode().IsMasterNode() {
+				p.changeMaster(node)
+			}
+		case protocol.NodeRole_VIEWER:
+			leaving = node
+			_ = p.transitRole(node, leaveTrigger)
 		}
 	}
-	if msg.GetRoleChange().ReceiverRole != nil {
-		p.gameInfo.CurrentNode().SetRole(msg.GetRoleChange().GetSenderRole())
+	if changeOwnRole {
+		_ = p.transitRole(p.gameInfo.CurrentNode(), ownTrigger)
+	}
+	// MASTER deletes the node that has left the game (the old MASTER after the handover too)
+	if leaving != nil && p.gameInfo.CurrentNode().IsMasterNode() {
+		p.deletePlayer(leaving)
+	}
+	if changeOwnRole && p.gameInfo.CurrentNode().IsMasterNode() {
+		p.announceNewMaster()
 	}
 	p.sendAckMsg(msg.GetMsgSeq(), msg.GetReceiverId(), msg.GetSenderId(), addr)
 
@@ -169,12 +220,20 @@ func (p *Peer) handleStateMsg(msg *protocol.GameMessage, addr *net.UDPAddr) {
 		p.sendErrorMsg(msg.GetMsgSeq(), msg.GetReceiverId(), msg.GetSenderId(), receiverIsMasterError, addr)
 		return
 	}
-	if p.gameInfo.StateOrder() >= msg.GetState().GetState().GetStateOrder() {
-		return
-	}
 
+	// The resent or outdated state is acknowledged but not applied
 	p.sendAckMsg(msg.GetMsgSeq(), msg.GetReceiverId(), msg.GetSenderId(), addr)
-	p.gameInfo.SetState(msg.GetReceiverId(), msg.GetState().GetState(), addr)
+	if p.gameInfo.StateOrder() < msg.GetState().GetState().GetStateOrder() {
+		p.gameInfo.SetState(msg.GetReceiverId(), msg.GetState().GetState(), addr)
+
+		// The state without MASTER comes from DEPUTY that has taken over the game
+		if deputy := p.gameInfo.DeputyNode(); p.gameInfo.MasterNode() == nil && deputy != nil &&
+			deputy.PlayerId() != p.gameInfo.CurrentNode().PlayerId() {
+			deputy.SetAddr(addr)
+			_ = p.transitRole(deputy, masterExpiredTrigger)
+		}
+		p.followMaster()
+	}
 	if node, ok := p.gameInfo.Node(msg.GetSenderId()); ok {
 		node.UpdateTimeAsNow()
 	}
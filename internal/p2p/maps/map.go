@@ -0,0 +1,163 @@
+package maps
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"p2p-snake/internal/log"
+	"p2p-snake/internal/p2p/protocol"
+)
+
+const mapFileExt = ".json"
+
+// Map cells are described with ASCII art
+const (
+	emptyCell = '.'
+	wallCell  = '#'
+	spawnCell = 'S'
+	foodCell  = 'F'
+)
+
+var (
+	mapsDirNotConfiguredError = fmt.Errorf("maps directory is not configured")
+	notValidMapNameError      = fmt.Errorf("not valid map name")
+	emptyMapError             = fmt.Errorf("map has no cells")
+	notRectangularMapError    = fmt.Errorf("map rows should have the same length")
+)
+
+type mapFile struct {
+	Walled bool     `json:"walled"`
+	Cells  []string `json:"cells"`
+}
+
+type Map struct {
+	name        string
+	width       int32
+	height      int32
+	walled      bool
+	walls       []*protocol.GameState_Coord
+	spawnPoints []*protocol.GameState_Coord
+	foodSpots   []*protocol.GameState_Coord
+}
+
+func (m *Map) Name() string {
+	return m.name
+}
+
+func (m *Map) Width() int32 {
+	return m.width
+}
+
+func (m *Map) Height() int32 {
+	return m.height
+}
+
+func (m *Map) Walled() bool {
+	return m.walled
+}
+
+func (m *Map) Walls() []*protocol.GameState_Coord {
+	return m.walls
+}
+
+func (m *Map) SpawnPoints() []*protocol.GameState_Coord {
+	return m.spawnPoints
+}
+
+func (m *Map) FoodSpots() []*protocol.GameState_Coord {
+	return m.foodSpots
+}
+
+func Load(dir string, name string) (*Map, error) {
+	if dir == "" {
+		return nil, mapsDirNotConfiguredError
+	}
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return nil, notValidMapNameError
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, name+mapFileExt))
+	if err != nil {
+		return nil, fmt.Errorf("map \"%s\" not found", name)
+	}
+
+	var file mapFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("map \"%s\" is not valid: %v", name, err)
+	}
+
+	return parse(name, file)
+}
+
+func List(dir string) ([]*Map, error) {
+	if dir == "" {
+		return []*Map{}, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	maps := make([]*Map, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != mapFileExt {
+			continue
+		}
+
+		gameMap, err := Load(dir, strings.TrimSuffix(entry.Name(), mapFileExt))
+		if err != nil {
+			log.Logger.Warnf("skip map file %s: %v", entry.Name(), err)
+			continue
+		}
+		maps = append(maps, gameMap)
+	}
+	return maps, nil
+}
+
+func parse(name string, file mapFile) (*Map, error) {
+	if len(file.Cells) == 0 || len(file.Cells[0]) == 0 {
+		return nil, emptyMapError
+	}
+
+	gameMap := &Map{
+		name:        name,
+		width:       int32(len(file.Cells[0])),
+		height:      int32(len(file.Cells)),
+		walled:      file.Walled,
+		walls:       make([]*protocol.GameState_Coord, 0),
+		spawnPoints: make([]*protocol.GameState_Coord, 0),
+		foodSpots:   make([]*protocol.GameState_Coord, 0),
+	}
+
+	for y, row := range file.Cells {
+		if int32(len(row)) != gameMap.width {
+			return nil, notRectangularMapError
+		}
+
+		for x, cell := range []byte(row) {
+			coord := &protocol.GameState_Coord{
+				X: proto.Int32(int32(x)),
+				Y: proto.Int32(int32(y)),
+			}
+			switch cell {
+			case emptyCell:
+			case wallCell:
+				gameMap.walls = append(gameMap.walls, coord)
+			case spawnCell:
+				gameMap.spawnPoints = append(gameMap.spawnPoints, coord)
+			case foodCell:
+				gameMap.foodSpots = append(gameMap.foodSpots, coord)
+			default:
+				return nil, fmt.Errorf("unknown map cell '%c' at (%d, %d)", cell, x, y)
+			}
+		}
+	}
+
+	return gameMap, nil
+}
@@ -46,23 +46,37 @@ func (p *Peer) sendProto(msg *protocol.GameMessage, addr *net.UDPAddr) *protocol
 	return msg
 }
 
-func (p *Peer) sendProtoWithResponse(msg *protocol.GameMessage, timeout time.Duration, addr *net.UDPAddr) (*protocol.GameMessage, *protocol.GameMessage) {
-	respCh := make(chan *protocol.GameMessage)
-	p.notAckMsg[msg.GetMsgSeq()] = respCh
+// Sends the message and resends it every interval until it is acknowledged or the attempts run out. The message
+// addressed to the MASTER role is redirected to the new MASTER after the change of the MASTER
+func (p *Peer) sendProtoWithResponse(msg *protocol.GameMessage, receiverRole *protocol.NodeRole, interval time.Duration, addr *net.UDPAddr) deliveryResult {
+	waiter := p.sendProtoReliably(msg, receiverRole, interval, addr)
 
-	p.sendProto(msg, addr)
+	response, err := p.correlator.await(p.ctx, waiter, ackTimeout(interval))
+	if err != nil {
+		log.Logger.Debugf("P2P node error: message %d to %v is not delivered: %v", msg.GetMsgSeq(), addr, err)
+	}
+	return deliveryResult{response: response, err: err}
+}
 
-	timeoutCh := time.After(timeout)
-	select {
-	case response := <-respCh:
-		close(p.notAckMsg[msg.GetMsgSeq()])
-		delete(p.notAckMsg, msg.GetMsgSeq())
-		return msg, response
-	case <-timeoutCh:
-		close(p.notAckMsg[msg.GetMsgSeq()])
-		delete(p.notAckMsg, msg.GetMsgSeq())
-		return msg, nil
+// The retransmit queue gives up after the last attempt, the timeout only guards against a lost failure
+func ackTimeout(interval time.Duration) time.Duration {
+	return (interval + retransmitPeriod) * (maxSendAttempts + 1)
+}
+
+// Sends the message and puts it into the retransmit queue without waiting for the acknowledgement
+func (p *Peer) sendProtoReliably(msg *protocol.GameMessage, receiverRole *protocol.NodeRole, interval time.Duration, addr *net.UDPAddr) *ackWaiter {
+	pending := &pendingMsg{
+		msg:          msg,
+		addr:         addr,
+		receiverRole: receiverRole,
+		interval:     interval,
+		attempts:     1,
+		lastSend:     time.Now(),
 	}
+	waiter := p.correlator.register(pending.key())
+	p.retransmitQueue.push(pending)
+	p.sendProto(msg, addr)
+	return waiter
 }
 
 func (p *Peer) sendAckMsg(msgSeq int64, senderId int32, receiverId int32, addr *net.UDPAddr) *protocol.GameMessage {
@@ -72,18 +86,23 @@ func (p *Peer) sendAckMsg(msgSeq int64, senderId int32, receiverId int32, addr *
 	)
 }
 
+func (p *Peer) sendJoinAckMsg(msgSeq int64, senderId int32, receiverId int32, reconnectToken string, addr *net.UDPAddr) *protocol.GameMessage {
+	return p.sendProto(
+		protocol.NewJoinAckMsg(msgSeq, senderId, receiverId, reconnectToken),
+		addr,
+	)
+}
+
 func (p *Peer) sendAnnouncementMsg(gameInfo *game.GameInfo, addr *net.UDPAddr) *protocol.GameMessage {
-	curMsgSeq := p.msgSeq.Load()
-	p.msgSeq.Add(1)
+	curMsgSeq := p.nextMsgSeq()
 	return p.sendProto(
 		protocol.NewAnnouncementMsg(
 			curMsgSeq,
 			gameInfo.GameName(),
-			gameInfo.Width(),
-			gameInfo.Height(),
-			gameInfo.FoodStatic(),
-			int32(gameInfo.StateDelay()/time.Millisecond),
+			gameInfo.Config(),
 			gameInfo.Players(),
+			gameInfo.Teams(),
+			gameInfo.CanJoin(),
 		),
 		addr,
 	)
@@ -97,67 +116,82 @@ func (p *Peer) sendErrorMsg(msgSeq int64, senderId int32, receiverId int32, erro
 }
 
 func (p *Peer) sendDiscoverMsg(addr *net.UDPAddr) *protocol.GameMessage {
-	curMsgSeq := p.msgSeq.Load()
-	p.msgSeq.Add(1)
+	curMsgSeq := p.nextMsgSeq()
 	return p.sendProto(
 		protocol.NewDiscoverMsg(curMsgSeq),
 		addr,
 	)
 }
 
-func (p *Peer) sendJoinMsg(gameName string, playerName string, role protocol.NodeRole, addr *net.UDPAddr) (*protocol.GameMessage, *protocol.GameMessage) {
-	curMsgSeq := p.msgSeq.Load()
-	p.msgSeq.Add(1)
+func (p *Peer) sendJoinMsg(gameName string, playerName string, role protocol.NodeRole, reconnectToken string, team int32, stateDelay time.Duration, addr *net.UDPAddr) deliveryResult {
+	curMsgSeq := p.nextMsgSeq()
 	return p.sendProtoWithResponse(
-		protocol.NewJoinMsg(curMsgSeq, gameName, playerName, role),
-		time.Second,
+		protocol.NewJoinMsg(curMsgSeq, gameName, playerName, role, reconnectToken, team),
+		nil,
+		stateDelay/10,
 		addr,
 	)
 }
 
 func (p *Peer) sendPingMsg(senderId int32, receiverId int32, addr *net.UDPAddr) *protocol.GameMessage {
-	curMsgSeq := p.msgSeq.Load()
-	p.msgSeq.Add(1)
+	curMsgSeq := p.nextMsgSeq()
 	return p.sendProto(
 		protocol.NewPingMsg(curMsgSeq, senderId, receiverId),
 		addr,
 	)
 }
 
-func (p *Peer) sendRoleChangeMsg(senderId int32, receiverId int32, senderRole *protocol.NodeRole, receiverRole *protocol.NodeRole, addr *net.UDPAddr) (*protocol.GameMessage, *protocol.GameMessage) {
-	curMsgSeq := p.msgSeq.Load()
-	p.msgSeq.Add(1)
+func (p *Peer) sendRoleChangeMsg(senderId int32, receiverId int32, senderRole *protocol.NodeRole, receiverRole *protocol.NodeRole, addr *net.UDPAddr) deliveryResult {
+	curMsgSeq := p.nextMsgSeq()
 	return p.sendProtoWithResponse(
 		protocol.NewRoleChangeMsg(curMsgSeq, senderId, receiverId, senderRole, receiverRole),
-		p.gameInfo.StateDelay()*8/10,
+		p.receiverRole(receiverId),
+		p.gameInfo.StateDelay()/10,
 		addr,
 	)
 }
 
-func (p *Peer) sendStateMsg(senderId int32, receiverId int32, gameInfo *game.GameInfo, addr *net.UDPAddr) (*protocol.GameMessage, *protocol.GameMessage) {
-	curMsgSeq := p.msgSeq.Load()
-	p.msgSeq.Add(1)
-	return p.sendProtoWithResponse(
-		protocol.NewStateMsg(
-			curMsgSeq,
-			senderId,
-			receiverId,
-			gameInfo.StateOrder(),
-			gameInfo.Snakes(),
-			gameInfo.Foods(),
-			gameInfo.Players(),
-		),
-		p.gameInfo.StateDelay()*8/10,
+// Does not wait for the acknowledgement, so that an unresponsive node does not delay the game
+func (p *Peer) sendRoleChangeMsgReliably(senderId int32, receiverId int32, senderRole *protocol.NodeRole, receiverRole *protocol.NodeRole, addr *net.UDPAddr) *ackWaiter {
+	curMsgSeq := p.nextMsgSeq()
+	return p.sendProtoReliably(
+		protocol.NewRoleChangeMsg(curMsgSeq, senderId, receiverId, senderRole, receiverRole),
+		p.receiverRole(receiverId),
+		p.gameInfo.StateDelay()/10,
 		addr,
 	)
 }
 
-func (p *Peer) sendSteerMsg(senderId int32, receiverId int32, direction protocol.Direction, addr *net.UDPAddr) (*protocol.GameMessage, *protocol.GameMessage) {
-	curMsgSeq := p.msgSeq.Load()
-	p.msgSeq.Add(1)
+// Does not wait for the acknowledgement, so that an unresponsive node does not delay the next state
+func (p *Peer) sendStateMsg(senderId int32, receiverId int32, state *protocol.GameState, addr *net.UDPAddr) *protocol.GameMessage {
+	curMsgSeq := p.nextMsgSeq()
+	msg := protocol.NewStateMsg(curMsgSeq, senderId, receiverId, state)
+	p.sendProtoReliably(msg, p.receiverRole(receiverId), p.gameInfo.StateDelay()/10, addr)
+	return msg
+}
+
+func (p *Peer) sendSteerMsg(senderId int32, receiverId int32, direction protocol.Direction, addr *net.UDPAddr) deliveryResult {
+	curMsgSeq := p.nextMsgSeq()
 	return p.sendProtoWithResponse(
 		protocol.NewSteerMsg(curMsgSeq, senderId, receiverId, direction),
-		p.gameInfo.StateDelay()*8/10,
+		p.receiverRole(receiverId),
+		p.gameInfo.StateDelay()/10,
 		addr,
 	)
 }
+
+// Allocates msg_seq in one atomic step, so that concurrently sent messages never share it
+func (p *Peer) nextMsgSeq() int64 {
+	return p.msgSeq.Add(1) - 1
+}
+
+// Returns MASTER if the receiver is the MASTER node, such messages follow the MASTER after its change
+func (p *Peer) receiverRole(receiverId int32) *protocol.NodeRole {
+	if p.gameInfo == nil {
+		return nil
+	}
+	if master := p.gameInfo.MasterNode(); master != nil && master.PlayerId() == receiverId {
+		return protocol.NodeRole_MASTER.Enum()
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package p2p
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	"p2p-snake/internal/p2p/protocol"
+)
+
+// Messages sent at the same time by publishState, announceNewMaster and the handlers get different msg_seq
+func TestConcurrentMsgSeqAreUnique(t *testing.T) {
+	const senders, count = 16, 10000
+
+	peer := NewPeer(nil, 0, "")
+	seqs := make(chan int64, senders*count)
+	wg := &sync.WaitGroup{}
+	for sender := 0; sender < senders; sender++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < count; k++ {
+				seqs <- peer.nextMsgSeq()
+			}
+		}()
+	}
+	wg.Wait()
+	close(seqs)
+
+	unique := make(map[int64]bool)
+	for seq := range seqs {
+		if unique[seq] {
+			t.Fatalf("msg_seq %d is used twice", seq)
+		}
+		unique[seq] = true
+	}
+}
+
+// Every message sent concurrently waits for its own acknowledgement, no waiter is overwritten
+func TestConcurrentSendsGetUniqueMsgSeq(t *testing.T) {
+	const senders, count = 8, 200
+
+	g := newRoleTestGame(t, protocol.NodeRole_MASTER)
+	addr := g.remote.LocalAddr().(*net.UDPAddr)
+	state := g.peer.gameInfo.State()
+
+	seqs := make(chan int64, senders*count)
+	wg := &sync.WaitGroup{}
+	for sender := 0; sender < senders; sender++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < count; k++ {
+				msg := g.peer.sendStateMsg(g.ids[protocol.NodeRole_MASTER], g.ids[protocol.NodeRole_NORMAL], state, addr)
+				seqs <- msg.GetMsgSeq()
+			}
+		}()
+	}
+	wg.Wait()
+	close(seqs)
+
+	unique := make(map[int64]bool)
+	for seq := range seqs {
+		if unique[seq] {
+			t.Errorf("msg_seq %d is used twice", seq)
+		}
+		unique[seq] = true
+	}
+
+	g.peer.correlator.lock.Lock()
+	waiters := len(g.peer.correlator.waiters)
+	g.peer.correlator.lock.Unlock()
+	if waiters != senders*count {
+		t.Errorf("%d messages wait for the acknowledgement, want %d", waiters, senders*count)
+	}
+}
@@ -8,10 +8,14 @@ import (
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"p2p-snake/internal/log"
 	"p2p-snake/internal/p2p/announcements"
+	"p2p-snake/internal/p2p/bot"
 	"p2p-snake/internal/p2p/dto"
 	"p2p-snake/internal/p2p/game"
+	"p2p-snake/internal/p2p/maps"
 	"p2p-snake/internal/p2p/protocol"
 )
 
@@ -22,38 +26,68 @@ var (
 	masterIsNotRespondingError = fmt.Errorf("master node is not responding")
 	unexpectedResponseError    = fmt.Errorf("unexpected response")
 	notParticipateInGameError  = fmt.Errorf("node does not participate in game")
+	notMasterError             = fmt.Errorf("node is not master of game")
+	invalidRobotCountError     = fmt.Errorf("robot count must be positive")
+	robotNotFoundError         = fmt.Errorf("robot not found")
+	gameIsNotFinishedError     = fmt.Errorf("game is not finished")
 )
 
+// Player added by JoinMsg
+type joinAck struct {
+	playerId       int32
+	reconnectToken string
+}
+
 type Peer struct {
 	// Network
-	multicastAddr *net.UDPAddr
-	multicast     *net.UDPConn
-	unicast       *net.UDPConn
-	notAckMsg     map[int64]chan *protocol.GameMessage
+	multicastAddr   *net.UDPAddr
+	multicast       *net.UDPConn
+	unicast         *net.UDPConn
+	retransmitQueue *retransmitQueue
+	correlator      *correlator
 
 	// Game
-	msgSeq     *atomic.Int64
-	gameInfo   *game.GameInfo
-	cancelGame context.CancelFunc
+	msgSeq          *atomic.Int64
+	masterId        *atomic.Int32 // MASTER the pending messages to the MASTER role are addressed to
+	appointedDeputy *atomic.Int32 // Player waiting for the acknowledgement of the appointment as DEPUTY (0 if none)
+	gameInfo        *game.GameInfo
+	cancelGame      context.CancelFunc
+	reclaimTimeout  time.Duration
+	reconnectTokens map[string]string
+	joins           map[ackKey]joinAck // Accepted JoinMsg, to acknowledge them again when they are resent
+	joinsLock       *sync.Mutex
+	mapsDir         string
+	robots          *bot.Driver
 
 	// Announcements
 	announcementCollector *announcements.AnnouncementCollector
 
 	// Closing
+	ctx    context.Context
 	cancel context.CancelFunc
 	wg     *sync.WaitGroup
 }
 
-func NewPeer(multicastAddr *net.UDPAddr) *Peer {
+func NewPeer(multicastAddr *net.UDPAddr, reclaimTimeout time.Duration, mapsDir string) *Peer {
 	return &Peer{
-		multicastAddr: multicastAddr,
-		notAckMsg:     make(map[int64]chan *protocol.GameMessage),
-
-		msgSeq:     &atomic.Int64{},
-		cancelGame: func() {},
+		multicastAddr:   multicastAddr,
+		retransmitQueue: newRetransmitQueue(),
+		correlator:      newCorrelator(),
+
+		msgSeq:          &atomic.Int64{},
+		masterId:        &atomic.Int32{},
+		appointedDeputy: &atomic.Int32{},
+		cancelGame:      func() {},
+		reclaimTimeout:  reclaimTimeout,
+		reconnectTokens: make(map[string]string),
+		joins:           make(map[ackKey]joinAck),
+		joinsLock:       &sync.Mutex{},
+		mapsDir:         mapsDir,
+		robots:          bot.NewDriver(),
 
 		announcementCollector: announcements.NewAnnouncementCollector(),
 
+		ctx:    context.Background(),
 		cancel: func() {},
 		wg:     &sync.WaitGroup{},
 	}
@@ -82,11 +116,11 @@ func (p *Peer) Start() error {
 	p.announcementCollector.Start()
 
 	// Start listening on sockets
-	var ctx context.Context
-	ctx, p.cancel = context.WithCancel(context.Background())
-	p.wg.Add(2)
-	go p.listenMulticast(ctx)
-	go p.listenUnicast(ctx)
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	p.wg.Add(3)
+	go p.listenMulticast(p.ctx)
+	go p.listenUnicast(p.ctx)
+	go p.retransmitMessages(p.ctx)
 
 	return nil
 }
@@ -115,7 +149,8 @@ func (p *Peer) Close() error {
 
 //////////// CREATE GAME ////////////
 
-func (p *Peer) CreateGame(gameName string, width int32, height int32, foodStatic int32, stateDelay int32, playerName string) error {
+// Creates the game by the config, the map (if it is set) replaces the size and the walls of the field
+func (p *Peer) CreateGame(gameName string, mapName string, playerName string, config *protocol.GameConfig) error {
 	if p.gameInfo != nil {
 		return playerAlreadyInGameError
 	}
@@ -125,31 +160,36 @@ func (p *Peer) CreateGame(gameName string, width int32, height int32, foodStatic
 		return gameAlreadyExistsError
 	}
 
+	// Load map
+	if mapName != "" {
+		gameMap, err := maps.Load(p.mapsDir, mapName)
+		if err != nil {
+			return err
+		}
+		config = proto.Clone(config).(*protocol.GameConfig)
+		config.Width, config.Height = proto.Int32(gameMap.Width()), proto.Int32(gameMap.Height())
+		config.Walled, config.Walls = proto.Bool(gameMap.Walled()), gameMap.Walls()
+		config.SpawnPoints, config.FoodSpots = gameMap.SpawnPoints(), gameMap.FoodSpots()
+	}
+
 	// Init game
-	var err error
 	p.gameInfo = game.NewGameInfo()
-	if err = p.gameInfo.CreateNewGame(gameName, width, height, foodStatic, stateDelay); err != nil {
+	if err := p.gameInfo.CreateNewGame(gameName, config); err != nil {
 		return err
 	}
+	p.gameInfo.SetReclaimTimeout(p.reclaimTimeout)
 
 	// Add MASTER
-	player, err := p.gameInfo.AddPlayer(playerName, protocol.NodeRole_MASTER, nil)
+	player, _, err := p.gameInfo.AddPlayer(playerName, protocol.PlayerType_HUMAN, protocol.NodeRole_MASTER, 0, nil, "")
 	if err != nil {
 		return err
 	}
 	p.gameInfo.SetCurrentNode(player)
 
-	// Start sending messages with announcement and game state, receiving messages from players and
-	// deleting “dead” nodes
-	var ctx context.Context
-	ctx, p.cancelGame = context.WithCancel(context.Background())
-	p.wg.Add(4)
-	go p.announceGame(ctx)
-	go p.publishState(ctx)
-	go p.pingNode(ctx)
-	go p.deleteExpiredNode(ctx)
-
-	log.Logger.Infof("create new game \"%s\" (%dx%d, %dms)", gameName, width, height, stateDelay)
+	p.runGame()
+
+	log.Logger.Infof("create new game \"%s\" (%dx%d, %dms, seed %d)", gameName, config.GetWidth(), config.GetHeight(),
+		config.GetStateDelayMs(), p.gameInfo.Seed())
 	return nil
 }
 
@@ -189,43 +229,56 @@ func (p *Peer) publishState(ctx context.Context) {
 			log.Logger.Debug("publishState goroutine has completed")
 			return
 		case <-time.After(p.gameInfo.StateDelay()):
-			deadSnakes, err := p.gameInfo.GenerateNextState()
+			p.steerRobots()
+
+			events, err := p.gameInfo.GenerateNextState()
 			if err != nil {
 				log.Logger.Errorf("P2P node error: %v", err)
 				continue
 			}
 
+			state := p.gameInfo.State()
 			for playerId, node := range p.gameInfo.Nodes() {
-				if node != p.gameInfo.CurrentNode() {
+				if node != p.gameInfo.CurrentNode() && !node.IsLocalRobotNode() {
 					p.sendStateMsg(
 						p.gameInfo.CurrentNode().PlayerId(),
 						playerId,
-						p.gameInfo,
+						state,
 						node.Addr(),
 					)
 				}
 			}
 
-			for _, playerId := range deadSnakes {
-				if node, ok := p.gameInfo.Node(playerId); ok && node.Addr() != nil {
-					p.sendRoleChangeMsg(
-						p.gameInfo.CurrentNode().PlayerId(),
-						playerId,
-						nil,
-						protocol.NodeRole_VIEWER.Enum(),
-						node.Addr(),
-					)
-					node.SetRole(protocol.NodeRole_VIEWER)
+			for _, event := range events {
+				if event.GetType() == protocol.GameEvent_GAME_FINISHED {
+					log.Logger.Infof("game \"%s\" is finished (%v, winner %d)", p.gameInfo.GameName(),
+						p.gameInfo.Result().GetReason(), event.GetPlayerId())
 				}
+				if event.GetType() == protocol.GameEvent_SNAKE_RESPAWNED {
+					p.changeRole(event.GetPlayerId(), protocol.NodeRole_NORMAL)
+					continue
+				}
+				if event.GetType() != protocol.GameEvent_SNAKE_DIED {
+					continue
+				}
+
+				// With respawn the MASTER keeps running the game while waiting for a new snake
+				playerId := event.GetPlayerId()
+				if p.gameInfo.CurrentNode().PlayerId() == playerId && p.gameInfo.RespawnEnabled() {
+					continue
+				}
+				p.changeRole(playerId, protocol.NodeRole_VIEWER)
 				if p.gameInfo.CurrentNode().PlayerId() == playerId {
 					_ = p.ExitGame()
+					log.Logger.Debug("publishState goroutine has completed")
+					return
 				}
 			}
 
 			if !p.gameInfo.ExistsDeputyNode() {
 				// Appoint new DEPUTY
 				for _, normal := range p.gameInfo.NormalNodes() {
-					if p.appointDeputy(normal) {
+					if p.appointDeputy(ctx, normal) {
 						break
 					}
 				}
@@ -234,6 +287,30 @@ func (p *Peer) publishState(ctx context.Context) {
 	}
 }
 
+// Changes the role of the player's node, remote nodes are notified with RoleChangeMsg
+func (p *Peer) changeRole(playerId int32, role protocol.NodeRole) {
+	node, ok := p.gameInfo.Node(playerId)
+	if !ok || node.IsMasterNode() {
+		return
+	}
+	trigger := receiverRoleTriggers[role]
+	if _, err := nextRole(node.Role(), trigger); err != nil {
+		return
+	}
+	if node.Addr() != nil {
+		p.sendRoleChangeMsgReliably(
+			p.gameInfo.CurrentNode().PlayerId(),
+			playerId,
+			nil,
+			role.Enum(),
+			node.Addr(),
+		)
+	} else if !node.IsLocalRobotNode() {
+		return
+	}
+	_ = p.transitRole(node, trigger)
+}
+
 func (p *Peer) pingNode(ctx context.Context) {
 	defer p.wg.Done()
 
@@ -249,7 +326,7 @@ func (p *Peer) pingNode(ctx context.Context) {
 			return
 		case <-time.After(p.gameInfo.StateDelay() / 5):
 			for _, node := range p.gameInfo.Nodes() {
-				if !node.IsMasterNode() {
+				if !node.IsMasterNode() && !node.IsLocalRobotNode() {
 					p.sendPingMsg(p.gameInfo.CurrentNode().PlayerId(), node.PlayerId(), node.Addr())
 				}
 			}
@@ -272,14 +349,170 @@ func (p *Peer) deleteExpiredNode(ctx context.Context) {
 			return
 		case <-time.After(p.gameInfo.StateDelay() / 2):
 			for _, node := range p.gameInfo.Nodes() {
-				if time.Since(node.LastUpdateTime()) > p.gameInfo.StateDelay()*8/10 && !node.IsMasterNode() {
-					_ = p.gameInfo.DeletePlayer(node.PlayerId())
+				if time.Since(node.LastUpdateTime()) > p.gameInfo.StateDelay()*8/10 && !node.IsMasterNode() &&
+					!node.IsLocalRobotNode() {
+					p.deletePlayer(node)
 				}
 			}
 		}
 	}
 }
 
+// Deletes the player that has left the game or expired: its snake becomes a zombie, the node gets no more messages
+func (p *Peer) deletePlayer(node *game.NodeInfo) {
+	_ = p.gameInfo.DeletePlayer(node.PlayerId())
+	if node.Addr() == nil {
+		return
+	}
+	for _, key := range p.retransmitQueue.discardPeer(node.Addr()) {
+		p.correlator.fail(key)
+	}
+}
+
+func (p *Peer) steerRobots() {
+	robots := p.gameInfo.RobotNodes()
+	if len(robots) == 0 {
+		return
+	}
+
+	view := bot.NewView(p.gameInfo.Snapshot())
+	for _, robot := range robots {
+		if direction, ok := p.robots.NextDirection(view, robot.PlayerId()); ok {
+			_ = p.gameInfo.AddMove(robot.PlayerId(), direction)
+		}
+	}
+}
+
+//////////// ADD ROBOTS ////////////
+
+// Adds all robots or none of them if the field has no room for all
+func (p *Peer) AddRobots(count int32, strategyName string) ([]dto.RobotDto, error) {
+	if count <= 0 {
+		return nil, invalidRobotCountError
+	}
+	if p.gameInfo == nil {
+		return nil, notParticipateInGameError
+	}
+	if !p.gameInfo.CurrentNode().IsMasterNode() {
+		return nil, notMasterError
+	}
+
+	strategy, err := bot.NewStrategy(strategyName)
+	if err != nil {
+		return nil, err
+	}
+
+	robots, err := p.gameInfo.AddRobots(count)
+	if err != nil {
+		return nil, err
+	}
+	added := make(map[int32]bool)
+	for _, robot := range robots {
+		p.robots.Add(robot.PlayerId(), strategy)
+		added[robot.PlayerId()] = true
+		log.Logger.Infof("add robot %d (%s)", robot.PlayerId(), strategy.Name())
+	}
+
+	robotDtos, err := p.GetRobots()
+	if err != nil {
+		return nil, err
+	}
+
+	addedDtos := make([]dto.RobotDto, 0, count)
+	for _, robotDto := range robotDtos {
+		if added[robotDto.PlayerId] {
+			addedDtos = append(addedDtos, robotDto)
+		}
+	}
+	return addedDtos, nil
+}
+
+//////////// GET ROBOTS ////////////
+
+func (p *Peer) GetRobots() ([]dto.RobotDto, error) {
+	if p.gameInfo == nil {
+		return nil, notParticipateInGameError
+	}
+	if !p.gameInfo.CurrentNode().IsMasterNode() {
+		return nil, notMasterError
+	}
+
+	robotDtos := make([]dto.RobotDto, 0)
+	for _, player := range p.gameInfo.Players().GetPlayers() {
+		if node, ok := p.gameInfo.Node(player.GetId()); ok && node.IsLocalRobotNode() {
+			robotDtos = append(robotDtos, dto.ToRobotDto(player, p.robots.Strategy(player.GetId()).Name()))
+		}
+	}
+	return robotDtos, nil
+}
+
+//////////// REMOVE ROBOT ////////////
+
+func (p *Peer) RemoveRobot(playerId int32) error {
+	if p.gameInfo == nil {
+		return notParticipateInGameError
+	}
+	if !p.gameInfo.CurrentNode().IsMasterNode() {
+		return notMasterError
+	}
+
+	node, ok := p.gameInfo.Node(playerId)
+	if !ok || !node.IsLocalRobotNode() {
+		return robotNotFoundError
+	}
+
+	// The snake of the robot is removed rather than left as a zombie
+	if err := p.gameInfo.RemovePlayer(playerId); err != nil {
+		return err
+	}
+	p.robots.Delete(playerId)
+
+	log.Logger.Infof("remove robot %d", playerId)
+	return nil
+}
+
+//////////// RESTART ROUND ////////////
+
+func (p *Peer) RestartRound(keepScores bool) error {
+	if p.gameInfo == nil {
+		return notParticipateInGameError
+	}
+	if !p.gameInfo.CurrentNode().IsMasterNode() {
+		return notMasterError
+	}
+	if !p.gameInfo.IsFinished() {
+		return gameIsNotFinishedError
+	}
+
+	// The players who got new snakes are NORMAL again, remote nodes are told about it with RoleChangeMsg
+	if err := p.gameInfo.RestartRound(keepScores); err != nil {
+		return err
+	}
+	for _, snake := range p.gameInfo.Snakes() {
+		if node, ok := p.gameInfo.Node(snake.GetPlayerId()); ok && node.IsViewerNode() {
+			p.changeRole(node.PlayerId(), protocol.NodeRole_NORMAL)
+		}
+	}
+
+	log.Logger.Infof("game \"%s\" round %d is started", p.gameInfo.GameName(), p.gameInfo.Round())
+	return nil
+}
+
+//////////// GET MAPS ////////////
+
+func (p *Peer) GetMaps() ([]dto.MapDto, error) {
+	gameMaps, err := maps.List(p.mapsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	mapDtos := make([]dto.MapDto, len(gameMaps))
+	for i, gameMap := range gameMaps {
+		mapDtos[i] = dto.NewMapDto(gameMap.Name(), gameMap.Width(), gameMap.Height(), gameMap.Walled())
+	}
+	return mapDtos, nil
+}
+
 //////////// DISCOVER GAMES ////////////
 
 func (p *Peer) DiscoverGames() []dto.GameInfoDto {
@@ -289,7 +522,8 @@ func (p *Peer) DiscoverGames() []dto.GameInfoDto {
 
 //////////// JOIN GAME ////////////
 
-func (p *Peer) JoinGame(gameName string, playerName string, isPlayer bool) error {
+// Joins the game in the team (if it is 0, the team is chosen by the MASTER node)
+func (p *Peer) JoinGame(gameName string, playerName string, isPlayer bool, team int32) error {
 	if p.gameInfo != nil {
 		return playerAlreadyInGameError
 	}
@@ -306,31 +540,30 @@ func (p *Peer) JoinGame(gameName string, playerName string, isPlayer bool) error
 		role = protocol.NodeRole_VIEWER
 	}
 
-	_, res := p.sendJoinMsg(
+	res := p.sendJoinMsg(
 		gameName,
 		playerName,
 		role,
+		p.reconnectTokens[gameName],
+		team,
+		time.Duration(announcement.StateDelay())*time.Millisecond,
 		announcement.Addr(),
-	)
+	).response
 	if res == nil {
 		return masterIsNotRespondingError
 	}
 	if _, ok := res.GetType().(*protocol.GameMessage_Ack); ok {
 		p.gameInfo = game.NewGameInfo()
-		p.gameInfo.SetCurrentNode(game.NewNodeInfo(res.GetReceiverId(), role, nil))
-		_ = p.gameInfo.CreateNewGame(
-			announcement.GameName(),
-			announcement.Width(),
-			announcement.Height(),
-			announcement.FoodStatic(),
-			announcement.StateDelay(),
-		)
+		p.gameInfo.SetCurrentNode(game.NewNodeInfo(res.GetReceiverId(), protocol.PlayerType_HUMAN, role, nil))
+		_ = p.gameInfo.CreateNewGame(announcement.GameName(), announcement.Config())
+		p.gameInfo.SetReclaimTimeout(p.reclaimTimeout)
+
+		// Remember the token to reclaim the snake after reconnection
+		if reconnectToken := res.GetAck().GetReconnectToken(); reconnectToken != "" {
+			p.reconnectTokens[gameName] = reconnectToken
+		}
 
-		var ctx context.Context
-		ctx, p.cancelGame = context.WithCancel(context.Background())
-		p.wg.Add(2)
-		go p.pingMaster(ctx)
-		go p.deleteExpiredMaster(ctx)
+		p.runGame()
 
 		log.Logger.Infof("join to game %s (%dx%d, %d)", announcement.GameName(),
 			announcement.Width(), announcement.Height(), announcement.StateDelay())
@@ -379,49 +612,31 @@ func (p *Peer) deleteExpiredMaster(ctx context.Context) {
 		case <-time.After(p.gameInfo.StateDelay() / 2):
 			master := p.gameInfo.MasterNode()
 			if master != nil && time.Since(master.LastUpdateTime()) > p.gameInfo.StateDelay()*8/10 {
-				// Delete expired master
-				_ = p.gameInfo.DeletePlayer(master.PlayerId())
-
-				deputy := p.gameInfo.DeputyNode()
-				if deputy == nil {
-					_ = p.ExitGame()
-					continue
-				}
+				p.deleteMaster(master)
+			}
+		}
+	}
+}
 
-				deputy.SetRole(protocol.NodeRole_MASTER)
-				if !p.gameInfo.CurrentNode().IsMasterNode() {
-					deputy.UpdateTime(time.Now().Add(2 * p.gameInfo.StateDelay()))
-					continue
-				}
+// Deletes the expired MASTER: DEPUTY takes over the game, without DEPUTY the current node leaves it
+func (p *Peer) deleteMaster(master *game.NodeInfo) {
+	_ = p.gameInfo.DeletePlayer(master.PlayerId())
 
-				p.cancelGame()
-
-				// Broadcast message about new MASTER
-				for playerId, node := range p.gameInfo.Nodes() {
-					if !node.IsMasterNode() {
-						go p.sendRoleChangeMsg(
-							p.gameInfo.CurrentNode().PlayerId(),
-							playerId,
-							protocol.NodeRole_MASTER.Enum(),
-							nil,
-							node.Addr(),
-						)
-						node.UpdateTime(time.Now().Add(2 * p.gameInfo.StateDelay()))
-					}
-				}
+	deputy := p.gameInfo.DeputyNode()
+	if deputy == nil {
+		_ = p.ExitGame()
+		return
+	}
 
-				// Start sending messages with announcement and game state, receiving messages from
-				// players and deleting “dead” nodes
-				var ctx context.Context
-				ctx, p.cancelGame = context.WithCancel(context.Background())
-				p.wg.Add(4)
-				go p.announceGame(ctx)
-				go p.publishState(ctx)
-				go p.pingNode(ctx)
-				go p.deleteExpiredNode(ctx)
-			}
-		}
+	// The goroutines of MASTER replace the ones watching MASTER if the current node is DEPUTY
+	if err := p.transitRole(deputy, masterExpiredTrigger); err != nil {
+		return
+	}
+	if !p.gameInfo.CurrentNode().IsMasterNode() {
+		deputy.UpdateTime(time.Now().Add(2 * p.gameInfo.StateDelay()))
+		return
 	}
+	p.announceNewMaster()
 }
 
 //////////// GET GAME STATE ////////////
@@ -435,10 +650,28 @@ func (p *Peer) GetState() (dto.GameStateDto, error) {
 		p.gameInfo.Config(),
 		p.gameInfo.Snakes(),
 		p.gameInfo.Foods(),
+		p.gameInfo.FoodKinds(),
+		p.gameInfo.Items(),
 		p.gameInfo.Players(),
+		p.gameInfo.Events(),
+		p.gameInfo.Result(),
+		p.gameInfo.Round(),
+		p.gameInfo.ArenaLevel(),
+		p.gameInfo.NextShrinkOrder(),
+		p.gameInfo.ArenaWalls(),
+		p.gameInfo.Teams(),
 	), nil
 }
 
+//////////// GET STATS ////////////
+
+func (p *Peer) GetStats() ([]dto.PlayerStatsDto, error) {
+	if p.gameInfo == nil {
+		return nil, notParticipateInGameError
+	}
+	return dto.ToPlayerStatsDtos(p.gameInfo.Players()), nil
+}
+
 //////////// ADD MOVE ////////////
 
 func (p *Peer) AddMove(direction protocol.Direction) error {
@@ -450,12 +683,12 @@ func (p *Peer) AddMove(direction protocol.Direction) error {
 	}
 
 	if master := p.gameInfo.MasterNode(); master != nil {
-		_, res := p.sendSteerMsg(
+		res := p.sendSteerMsg(
 			p.gameInfo.CurrentNode().PlayerId(),
 			master.PlayerId(),
 			direction,
 			master.Addr(),
-		)
+		).response
 		if res == nil {
 			return masterIsNotRespondingError
 		} else if _, ok := res.GetType().(*protocol.GameMessage_Ack); ok {
@@ -476,27 +709,76 @@ func (p *Peer) ExitGame() error {
 		return notParticipateInGameError
 	}
 	p.cancelGame()
+	p.leaveGame()
 	p.gameInfo = nil
+	p.masterId.Store(0)
+	p.joinsLock.Lock()
+	p.joins = make(map[ackKey]joinAck)
+	p.joinsLock.Unlock()
+	p.robots.Clear()
 	return nil
 }
 
-func (p *Peer) appointDeputy(player *game.NodeInfo) bool {
-	if !p.gameInfo.CurrentNode().IsMasterNode() {
+// Tells the other nodes that the current node leaves the game: MASTER hands the game over to DEPUTY and waits for
+// the acknowledgement, the player becomes a VIEWER
+func (p *Peer) leaveGame() {
+	current := p.gameInfo.CurrentNode()
+	switch current.Role() {
+	case protocol.NodeRole_MASTER:
+		deputy := p.gameInfo.DeputyNode()
+		if deputy == nil {
+			return
+		}
+		res := p.sendRoleChangeMsg(
+			current.PlayerId(),
+			deputy.PlayerId(),
+			protocol.NodeRole_VIEWER.Enum(),
+			protocol.NodeRole_MASTER.Enum(),
+			deputy.Addr(),
+		).response
+		if _, ok := res.GetType().(*protocol.GameMessage_Ack); !ok {
+			log.Logger.Errorf("P2P node error: game \"%s\" is not handed over to DEPUTY", p.gameInfo.GameName())
+			return
+		}
+		log.Logger.Infof("game \"%s\" is handed over to player %d", p.gameInfo.GameName(), deputy.PlayerId())
+	case protocol.NodeRole_DEPUTY, protocol.NodeRole_NORMAL:
+		if master := p.gameInfo.MasterNode(); master != nil {
+			p.sendRoleChangeMsg(
+				current.PlayerId(),
+				master.PlayerId(),
+				protocol.NodeRole_VIEWER.Enum(),
+				nil,
+				master.Addr(),
+			)
+		}
+	}
+}
+
+// Asks the player to become DEPUTY, the player becomes DEPUTY when the acknowledgement comes. Returns false if
+// another player is being appointed already
+func (p *Peer) appointDeputy(ctx context.Context, player *game.NodeInfo) bool {
+	if !p.gameInfo.CurrentNode().IsMasterNode() || !p.appointedDeputy.CompareAndSwap(0, player.PlayerId()) {
 		return false
 	}
 
-	_, res := p.sendRoleChangeMsg(
+	interval := p.gameInfo.StateDelay() / 10
+	waiter := p.sendRoleChangeMsgReliably(
 		p.gameInfo.CurrentNode().PlayerId(),
 		player.PlayerId(),
 		nil,
 		protocol.NodeRole_DEPUTY.Enum(),
 		player.Addr(),
 	)
-	if res != nil {
+	go func() {
+		defer p.appointedDeputy.Store(0)
+		res, err := p.correlator.await(ctx, waiter, ackTimeout(interval))
+		if err != nil {
+			log.Logger.Debugf("P2P node error: player %d is not appointed DEPUTY: %v", player.PlayerId(), err)
+			return
+		}
 		if _, ok := res.GetType().(*protocol.GameMessage_Ack); ok {
-			player.SetRole(protocol.NodeRole_DEPUTY)
-			return true
+			_ = p.transitRole(player, appointDeputyTrigger)
 		}
-	}
-	return false
+	}()
+	return true
 }
@@ -0,0 +1,155 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"p2p-snake/internal/p2p/bot"
+	"p2p-snake/internal/p2p/game"
+	"p2p-snake/internal/p2p/protocol"
+)
+
+// Starts receiving the messages sent to the peer of the game
+func (g *roleTestGame) listen() {
+	g.peer.wg.Add(1)
+	go g.peer.listenUnicast(g.peer.ctx)
+}
+
+// Second peer of the same game, the current node of which has the given role. The peers know the addresses of each
+// other
+func (g *roleTestGame) newSecondPeer(t *testing.T, role protocol.NodeRole) *Peer {
+	t.Helper()
+
+	peer := NewPeer(&net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9}, time.Second, "")
+	var err error
+	peer.unicast, err = net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	peer.ctx, peer.cancel = context.WithCancel(context.Background())
+
+	node, _ := g.peer.gameInfo.Node(g.ids[role])
+	node.SetAddr(peer.unicast.LocalAddr().(*net.UDPAddr))
+	current := g.peer.gameInfo.CurrentNode()
+	current.SetAddr(g.peer.unicast.LocalAddr().(*net.UDPAddr))
+	state := g.peer.gameInfo.State()
+	current.SetAddr(nil)
+
+	peer.gameInfo = game.NewGameInfo()
+	if err := peer.gameInfo.CreateNewGame("test", newTestConfig()); err != nil {
+		t.Fatal(err)
+	}
+	master := g.peer.gameInfo.MasterNode().Addr()
+	if master == nil {
+		master = g.peer.unicast.LocalAddr().(*net.UDPAddr)
+	}
+	peer.gameInfo.SetState(g.ids[role], state, master)
+	peer.gameInfo.CurrentNode().SetAddr(nil)
+	peer.followMaster()
+
+	peer.wg.Add(2)
+	go peer.retransmitMessages(peer.ctx)
+	go peer.listenUnicast(peer.ctx)
+
+	t.Cleanup(func() {
+		peer.cancelGame()
+		peer.cancel()
+		peer.wg.Wait()
+		_ = peer.unicast.Close()
+	})
+	return peer
+}
+
+// The player leaving the game tells the staying nodes about it, MASTER hands the game over to DEPUTY first
+func TestExitGame(t *testing.T) {
+	tests := []struct {
+		name       string
+		leaving    protocol.NodeRole
+		staying    protocol.NodeRole
+		wantMaster protocol.NodeRole // Player who is MASTER for the staying node
+	}{
+		{
+			name:       "MASTER hands the game over to DEPUTY",
+			leaving:    protocol.NodeRole_MASTER,
+			staying:    protocol.NodeRole_DEPUTY,
+			wantMaster: protocol.NodeRole_DEPUTY,
+		},
+		{
+			name:       "NORMAL leaves the game",
+			leaving:    protocol.NodeRole_NORMAL,
+			staying:    protocol.NodeRole_MASTER,
+			wantMaster: protocol.NodeRole_MASTER,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := newRoleTestGame(t, test.leaving)
+			g.listen()
+			staying := g.newSecondPeer(t, test.staying)
+			leavingId := g.ids[test.leaving]
+
+			// The staying node has answered at once, the game goes on without waiting for the timeouts
+			start := time.Now()
+			if err := g.peer.ExitGame(); err != nil {
+				t.Fatal(err)
+			}
+			if elapsed := time.Since(start); elapsed > staying.gameInfo.StateDelay()/10 {
+				t.Errorf("leaving has taken %v, want no resending", elapsed)
+			}
+			if g.peer.gameInfo != nil {
+				t.Error("leaving node is still in the game")
+			}
+
+			if _, ok := staying.gameInfo.Node(leavingId); ok {
+				t.Errorf("leaving player %d is not deleted", leavingId)
+			}
+			for _, snake := range staying.gameInfo.Snakes() {
+				if snake.GetPlayerId() == leavingId && snake.GetState() != protocol.GameState_Snake_ZOMBIE {
+					t.Errorf("snake of the leaving player = %v, want ZOMBIE", snake)
+				}
+			}
+			master := staying.gameInfo.MasterNode()
+			if master == nil || master.PlayerId() != g.ids[test.wantMaster] {
+				t.Fatalf("MASTER = %v, want %v", master, test.wantMaster)
+			}
+			if got := staying.masterId.Load(); got != master.PlayerId() {
+				t.Errorf("messages follow %d, want MASTER %d", got, master.PlayerId())
+			}
+		})
+	}
+}
+
+// The removed robot leaves no zombie snake behind, the human players are not removed as robots
+func TestRemoveRobot(t *testing.T) {
+	g := newRoleTestGame(t, protocol.NodeRole_MASTER)
+	robots, err := g.peer.AddRobots(1, bot.GreedyStrategyName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	robotId := robots[0].PlayerId
+
+	if err := g.peer.RemoveRobot(g.ids[protocol.NodeRole_NORMAL]); err != robotNotFoundError {
+		t.Errorf("RemoveRobot(NORMAL) = %v, want %v", err, robotNotFoundError)
+	}
+	if err := g.peer.RemoveRobot(robotId); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, snake := range g.peer.gameInfo.Snakes() {
+		if snake.GetPlayerId() == robotId {
+			t.Errorf("snake of the removed robot = %v, want none", snake)
+		}
+	}
+	if _, ok := g.peer.gameInfo.Node(robotId); ok {
+		t.Errorf("node of the removed robot %d is left", robotId)
+	}
+	if robots, _ := g.peer.GetRobots(); len(robots) != 0 {
+		t.Errorf("robots = %v, want none", robots)
+	}
+	if err := g.peer.RemoveRobot(robotId); err != robotNotFoundError {
+		t.Errorf("RemoveRobot() again = %v, want %v", err, robotNotFoundError)
+	}
+}
@@ -15,8 +15,20 @@ func NewAckMsg(msgSeq int64, senderId int32, receiverId int32) *GameMessage {
 	}
 }
 
-func NewAnnouncementMsg(msgSeq int64, gameName string, width int32, height int32, foodStatic int32,
-	stateDelay int32, players *GamePlayers) *GameMessage {
+func NewJoinAckMsg(msgSeq int64, senderId int32, receiverId int32, reconnectToken string) *GameMessage {
+	return &GameMessage{
+		MsgSeq:     proto.Int64(msgSeq),
+		SenderId:   proto.Int32(senderId),
+		ReceiverId: proto.Int32(receiverId),
+		Type: &GameMessage_Ack{
+			Ack: &GameMessage_AckMsg{
+				ReconnectToken: proto.String(reconnectToken),
+			},
+		},
+	}
+}
+
+func NewAnnouncementMsg(msgSeq int64, gameName string, config *GameConfig, players *GamePlayers, teams []*Team, canJoin bool) *GameMessage {
 	return &GameMessage{
 		MsgSeq: proto.Int64(msgSeq),
 		Type: &GameMessage_Announcement{
@@ -24,14 +36,10 @@ func NewAnnouncementMsg(msgSeq int64, gameName string, width int32, height int32
 				Games: []*GameAnnouncement{
 					{
 						GameName: proto.String(gameName),
-						Config: &GameConfig{
-							Width:        proto.Int32(width),
-							Height:       proto.Int32(height),
-							FoodStatic:   proto.Int32(foodStatic),
-							StateDelayMs: proto.Int32(stateDelay),
-						},
-						CanJoin: proto.Bool(true),
-						Players: players,
+						Config:   config,
+						CanJoin:  proto.Bool(canJoin),
+						Players:  players,
+						Teams:    teams,
 					},
 				},
 			},
@@ -61,15 +69,27 @@ func NewErrorMsg(msgSeq int64, senderId int32, receiverId int32, error string) *
 	}
 }
 
-func NewJoinMsg(msgSeq int64, gameName string, playerName string, role NodeRole) *GameMessage {
+func NewJoinMsg(msgSeq int64, gameName string, playerName string, role NodeRole, reconnectToken string, team int32) *GameMessage {
+	var token *string = nil
+	if reconnectToken != "" {
+		token = proto.String(reconnectToken)
+	}
+
+	var teamId *int32 = nil
+	if team != 0 {
+		teamId = proto.Int32(team)
+	}
+
 	return &GameMessage{
 		MsgSeq: proto.Int64(msgSeq),
 		Type: &GameMessage_Join{
 			Join: &GameMessage_JoinMsg{
-				GameName:      proto.String(gameName),
-				PlayerName:    proto.String(playerName),
-				PlayerType:    (*PlayerType)(proto.Int32((int32)(Default_GamePlayer_Type))),
-				RequestedRole: (*NodeRole)(proto.Int32((int32)(role))),
+				GameName:       proto.String(gameName),
+				PlayerName:     proto.String(playerName),
+				PlayerType:     (*PlayerType)(proto.Int32((int32)(Default_GamePlayer_Type))),
+				RequestedRole:  (*NodeRole)(proto.Int32((int32)(role))),
+				ReconnectToken: token,
+				Team:           teamId,
 			},
 		},
 	}
@@ -100,20 +120,14 @@ func NewRoleChangeMsg(msgSeq int64, senderId int32, receiverId int32, senderRole
 	}
 }
 
-func NewStateMsg(msgSeq int64, senderId int32, receiverId int32, stateOrder int32,
-	snakes []*GameState_Snake, foods []*GameState_Coord, players *GamePlayers) *GameMessage {
+func NewStateMsg(msgSeq int64, senderId int32, receiverId int32, state *GameState) *GameMessage {
 	return &GameMessage{
 		MsgSeq:     proto.Int64(msgSeq),
 		SenderId:   proto.Int32(senderId),
 		ReceiverId: proto.Int32(receiverId),
 		Type: &GameMessage_State{
 			State: &GameMessage_StateMsg{
-				State: &GameState{
-					StateOrder: proto.Int32(stateOrder),
-					Snakes:     snakes,
-					Foods:      foods,
-					Players:    players,
-				},
+				State: state,
 			},
 		},
 	}
@@ -203,6 +203,66 @@ func (Direction) EnumDescriptor() ([]byte, []int) {
 	return file_p2p_proto_rawDescGZIP(), []int{2}
 }
 
+// Вид еды (расширение протокола)
+type GameState_FoodKind int32
+
+const (
+	GameState_NORMAL_FOOD GameState_FoodKind = 0 // 1 очко, змея вырастает на 1 клетку
+	GameState_GOLDEN_FOOD GameState_FoodKind = 1 // 3 очка, змея вырастает на 3 клетки
+	GameState_POISON_FOOD GameState_FoodKind = 2 // 0 очков, змея теряет 2 клетки хвоста
+)
+
+// Enum value maps for GameState_FoodKind.
+var (
+	GameState_FoodKind_name = map[int32]string{
+		0: "NORMAL_FOOD",
+		1: "GOLDEN_FOOD",
+		2: "POISON_FOOD",
+	}
+	GameState_FoodKind_value = map[string]int32{
+		"NORMAL_FOOD": 0,
+		"GOLDEN_FOOD": 1,
+		"POISON_FOOD": 2,
+	}
+)
+
+func (x GameState_FoodKind) Enum() *GameState_FoodKind {
+	p := new(GameState_FoodKind)
+	*p = x
+	return p
+}
+
+func (x GameState_FoodKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameState_FoodKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_p2p_proto_enumTypes[3].Descriptor()
+}
+
+func (GameState_FoodKind) Type() protoreflect.EnumType {
+	return &file_p2p_proto_enumTypes[3]
+}
+
+func (x GameState_FoodKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *GameState_FoodKind) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = GameState_FoodKind(num)
+	return nil
+}
+
+// Deprecated: Use GameState_FoodKind.Descriptor instead.
+func (GameState_FoodKind) EnumDescriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{5, 0}
+}
+
 // Статус змеи в игре
 type GameState_Snake_SnakeState int32
 
@@ -234,11 +294,11 @@ func (x GameState_Snake_SnakeState) String() string {
 }
 
 func (GameState_Snake_SnakeState) Descriptor() protoreflect.EnumDescriptor {
-	return file_p2p_proto_enumTypes[3].Descriptor()
+	return file_p2p_proto_enumTypes[4].Descriptor()
 }
 
 func (GameState_Snake_SnakeState) Type() protoreflect.EnumType {
-	return &file_p2p_proto_enumTypes[3]
+	return &file_p2p_proto_enumTypes[4]
 }
 
 func (x GameState_Snake_SnakeState) Number() protoreflect.EnumNumber {
@@ -257,7 +317,271 @@ func (x *GameState_Snake_SnakeState) UnmarshalJSON(b []byte) error {
 
 // Deprecated: Use GameState_Snake_SnakeState.Descriptor instead.
 func (GameState_Snake_SnakeState) EnumDescriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{3, 1, 0}
+	return file_p2p_proto_rawDescGZIP(), []int{5, 1, 0}
+}
+
+// Вид бонуса
+type GameState_Item_Kind int32
+
+const (
+	GameState_Item_SPEED_BOOST      GameState_Item_Kind = 0 // Змея перемещается на две клетки за ход
+	GameState_Item_SHRINK           GameState_Item_Kind = 1 // Змея теряет две последние клетки хвоста
+	GameState_Item_GHOST            GameState_Item_Kind = 2 // Змея проходит сквозь других змей
+	GameState_Item_SCORE_MULTIPLIER GameState_Item_Kind = 3 // Игрок получает вдвое больше очков
+)
+
+// Enum value maps for GameState_Item_Kind.
+var (
+	GameState_Item_Kind_name = map[int32]string{
+		0: "SPEED_BOOST",
+		1: "SHRINK",
+		2: "GHOST",
+		3: "SCORE_MULTIPLIER",
+	}
+	GameState_Item_Kind_value = map[string]int32{
+		"SPEED_BOOST":      0,
+		"SHRINK":           1,
+		"GHOST":            2,
+		"SCORE_MULTIPLIER": 3,
+	}
+)
+
+func (x GameState_Item_Kind) Enum() *GameState_Item_Kind {
+	p := new(GameState_Item_Kind)
+	*p = x
+	return p
+}
+
+func (x GameState_Item_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameState_Item_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_p2p_proto_enumTypes[5].Descriptor()
+}
+
+func (GameState_Item_Kind) Type() protoreflect.EnumType {
+	return &file_p2p_proto_enumTypes[5]
+}
+
+func (x GameState_Item_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *GameState_Item_Kind) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = GameState_Item_Kind(num)
+	return nil
+}
+
+// Deprecated: Use GameState_Item_Kind.Descriptor instead.
+func (GameState_Item_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{5, 2, 0}
+}
+
+// Причина окончания игры
+type GameResult_FinishReason int32
+
+const (
+	GameResult_TURN_LIMIT  GameResult_FinishReason = 0 // Прошло заданное число ходов
+	GameResult_SCORE_LIMIT GameResult_FinishReason = 1 // Игрок набрал заданное число очков
+	GameResult_LAST_SNAKE  GameResult_FinishReason = 2 // В живых осталась не больше чем одна змея
+)
+
+// Enum value maps for GameResult_FinishReason.
+var (
+	GameResult_FinishReason_name = map[int32]string{
+		0: "TURN_LIMIT",
+		1: "SCORE_LIMIT",
+		2: "LAST_SNAKE",
+	}
+	GameResult_FinishReason_value = map[string]int32{
+		"TURN_LIMIT":  0,
+		"SCORE_LIMIT": 1,
+		"LAST_SNAKE":  2,
+	}
+)
+
+func (x GameResult_FinishReason) Enum() *GameResult_FinishReason {
+	p := new(GameResult_FinishReason)
+	*p = x
+	return p
+}
+
+func (x GameResult_FinishReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameResult_FinishReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_p2p_proto_enumTypes[6].Descriptor()
+}
+
+func (GameResult_FinishReason) Type() protoreflect.EnumType {
+	return &file_p2p_proto_enumTypes[6]
+}
+
+func (x GameResult_FinishReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *GameResult_FinishReason) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = GameResult_FinishReason(num)
+	return nil
+}
+
+// Deprecated: Use GameResult_FinishReason.Descriptor instead.
+func (GameResult_FinishReason) EnumDescriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{6, 0}
+}
+
+// Тип события
+type GameEvent_EventType int32
+
+const (
+	GameEvent_FOOD_EATEN      GameEvent_EventType = 0 // Змея съела еду
+	GameEvent_SNAKE_DIED      GameEvent_EventType = 1 // Змея погибла
+	GameEvent_POINTS_AWARDED  GameEvent_EventType = 2 // Игрок получил очки
+	GameEvent_FOOD_SPAWNED    GameEvent_EventType = 3 // На поле появилась еда
+	GameEvent_ZOMBIE_CREATED  GameEvent_EventType = 4 // Змея стала зомби
+	GameEvent_GAME_FINISHED   GameEvent_EventType = 5 // Игра закончилась
+	GameEvent_ITEM_SPAWNED    GameEvent_EventType = 6 // На поле появился бонус
+	GameEvent_ITEM_PICKED_UP  GameEvent_EventType = 7 // Змея подобрала бонус
+	GameEvent_SNAKE_RESPAWNED GameEvent_EventType = 8 // Игрок получил новую змею после гибели
+)
+
+// Enum value maps for GameEvent_EventType.
+var (
+	GameEvent_EventType_name = map[int32]string{
+		0: "FOOD_EATEN",
+		1: "SNAKE_DIED",
+		2: "POINTS_AWARDED",
+		3: "FOOD_SPAWNED",
+		4: "ZOMBIE_CREATED",
+		5: "GAME_FINISHED",
+		6: "ITEM_SPAWNED",
+		7: "ITEM_PICKED_UP",
+		8: "SNAKE_RESPAWNED",
+	}
+	GameEvent_EventType_value = map[string]int32{
+		"FOOD_EATEN":      0,
+		"SNAKE_DIED":      1,
+		"POINTS_AWARDED":  2,
+		"FOOD_SPAWNED":    3,
+		"ZOMBIE_CREATED":  4,
+		"GAME_FINISHED":   5,
+		"ITEM_SPAWNED":    6,
+		"ITEM_PICKED_UP":  7,
+		"SNAKE_RESPAWNED": 8,
+	}
+)
+
+func (x GameEvent_EventType) Enum() *GameEvent_EventType {
+	p := new(GameEvent_EventType)
+	*p = x
+	return p
+}
+
+func (x GameEvent_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_p2p_proto_enumTypes[7].Descriptor()
+}
+
+func (GameEvent_EventType) Type() protoreflect.EnumType {
+	return &file_p2p_proto_enumTypes[7]
+}
+
+func (x GameEvent_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *GameEvent_EventType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = GameEvent_EventType(num)
+	return nil
+}
+
+// Deprecated: Use GameEvent_EventType.Descriptor instead.
+func (GameEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{7, 0}
+}
+
+// Причина гибели змеи
+type GameEvent_DeathCause int32
+
+const (
+	GameEvent_SELF    GameEvent_DeathCause = 0 // Змея врезалась в себя
+	GameEvent_SNAKE   GameEvent_DeathCause = 1 // Змея врезалась в тело другой змеи
+	GameEvent_HEAD_ON GameEvent_DeathCause = 2 // Голова змеи наехала на голову другой змеи
+	GameEvent_WALL    GameEvent_DeathCause = 3 // Змея врезалась в стену или край поля
+)
+
+// Enum value maps for GameEvent_DeathCause.
+var (
+	GameEvent_DeathCause_name = map[int32]string{
+		0: "SELF",
+		1: "SNAKE",
+		2: "HEAD_ON",
+		3: "WALL",
+	}
+	GameEvent_DeathCause_value = map[string]int32{
+		"SELF":    0,
+		"SNAKE":   1,
+		"HEAD_ON": 2,
+		"WALL":    3,
+	}
+)
+
+func (x GameEvent_DeathCause) Enum() *GameEvent_DeathCause {
+	p := new(GameEvent_DeathCause)
+	*p = x
+	return p
+}
+
+func (x GameEvent_DeathCause) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameEvent_DeathCause) Descriptor() protoreflect.EnumDescriptor {
+	return file_p2p_proto_enumTypes[8].Descriptor()
+}
+
+func (GameEvent_DeathCause) Type() protoreflect.EnumType {
+	return &file_p2p_proto_enumTypes[8]
+}
+
+func (x GameEvent_DeathCause) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *GameEvent_DeathCause) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = GameEvent_DeathCause(num)
+	return nil
+}
+
+// Deprecated: Use GameEvent_DeathCause.Descriptor instead.
+func (GameEvent_DeathCause) EnumDescriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{7, 1}
 }
 
 // Игрок
@@ -266,37 +590,648 @@ type GamePlayer struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      *string     `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`                            // Имя игрока (для отображения в интерфейсе)
-	Id        *int32      `protobuf:"varint,2,req,name=playerid" json:"playerid,omitempty"`                   // Уникальный идентификатор игрока в пределах игры
-	IpAddress *string     `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress" json:"ip_address,omitempty"` // IPv4 или IPv6 адрес игрока в виде строки. Отсутствует в описании игрока-отправителя сообщения
-	Port      *int32      `protobuf:"varint,4,opt,name=port" json:"port,omitempty"`                           // Порт UDP-сокета игрока. Отсутствует в описании игрока-отправителя сообщения
-	Role      *NodeRole   `protobuf:"varint,5,req,name=role,enum=p2p.NodeRole" json:"role,omitempty"`         // Роль узла в топологии
-	Type      *PlayerType `protobuf:"varint,6,opt,name=type,enum=p2p.PlayerType,def=0" json:"type,omitempty"` // Тип игрока
-	Score     *int32      `protobuf:"varint,7,req,name=score" json:"score,omitempty"`                         // Число очков, которые набрал игрок
-}
-
-// Default values for GamePlayer fields.
+	Name               *string      `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`                                                         // Имя игрока (для отображения в интерфейсе)
+	Id                 *int32       `protobuf:"varint,2,req,name=id" json:"id,omitempty"`                                                            // Уникальный идентификатор игрока в пределах игры
+	IpAddress          *string      `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress" json:"ip_address,omitempty"`                              // IPv4 или IPv6 адрес игрока в виде строки. Отсутствует в описании игрока-отправителя сообщения
+	Port               *int32       `protobuf:"varint,4,opt,name=port" json:"port,omitempty"`                                                        // Порт UDP-сокета игрока. Отсутствует в описании игрока-отправителя сообщения
+	Role               *NodeRole    `protobuf:"varint,5,req,name=role,enum=p2p.NodeRole" json:"role,omitempty"`                                      // Роль узла в топологии
+	Type               *PlayerType  `protobuf:"varint,6,opt,name=type,enum=p2p.PlayerType,def=0" json:"type,omitempty"`                              // Тип игрока
+	Score              *int32       `protobuf:"varint,7,req,name=score" json:"score,omitempty"`                                                      // Число очков, которые набрал игрок
+	ReconnectTokenHash *string      `protobuf:"bytes,8,opt,name=reconnect_token_hash,json=reconnectTokenHash" json:"reconnect_token_hash,omitempty"` // Хэш токена для возвращения в игру (расширение протокола)
+	Spectator          *bool        `protobuf:"varint,9,opt,name=spectator,def=0" json:"spectator,omitempty"`                                        // Игрок вошёл без змеи и не получает её в новых раундах (расширение протокола)
+	Stats              *PlayerStats `protobuf:"bytes,10,opt,name=stats" json:"stats,omitempty"`                                                      // Статистика игрока (расширение протокола)
+	RespawnOrder       *int32       `protobuf:"varint,11,opt,name=respawn_order,json=respawnOrder" json:"respawn_order,omitempty"`                   // Номер состояния, в котором игрок получит новую змею после гибели (расширение протокола)
+	Team               *int32       `protobuf:"varint,12,opt,name=team,def=0" json:"team,omitempty"`                                                 // Команда игрока, 0 - игра без команд (расширение протокола)
+}
+
+// Default values for GamePlayer fields.
+const (
+	Default_GamePlayer_Type      = PlayerType_HUMAN
+	Default_GamePlayer_Spectator = bool(false)
+	Default_GamePlayer_Team      = int32(0)
+)
+
+func (x *GamePlayer) Reset() {
+	*x = GamePlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GamePlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamePlayer) ProtoMessage() {}
+
+func (x *GamePlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamePlayer.ProtoReflect.Descriptor instead.
+func (*GamePlayer) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GamePlayer) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetIpAddress() string {
+	if x != nil && x.IpAddress != nil {
+		return *x.IpAddress
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetRole() NodeRole {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return NodeRole_NORMAL
+}
+
+func (x *GamePlayer) GetType() PlayerType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return Default_GamePlayer_Type
+}
+
+func (x *GamePlayer) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetReconnectTokenHash() string {
+	if x != nil && x.ReconnectTokenHash != nil {
+		return *x.ReconnectTokenHash
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetSpectator() bool {
+	if x != nil && x.Spectator != nil {
+		return *x.Spectator
+	}
+	return Default_GamePlayer_Spectator
+}
+
+func (x *GamePlayer) GetStats() *PlayerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GamePlayer) GetRespawnOrder() int32 {
+	if x != nil && x.RespawnOrder != nil {
+		return *x.RespawnOrder
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetTeam() int32 {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return Default_GamePlayer_Team
+}
+
+// Команда игроков (расширение протокола)
+type Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *int32  `protobuf:"varint,1,req,name=id" json:"id,omitempty"`                                // Номер команды, команды нумеруются с 1
+	Score     *int32  `protobuf:"varint,2,opt,name=score,def=0" json:"score,omitempty"`                    // Число очков, которые набрали игроки команды
+	PlayerIds []int32 `protobuf:"varint,3,rep,name=player_ids,json=playerIds" json:"player_ids,omitempty"` // Идентификаторы игроков команды
+}
+
+// Default values for Team fields.
+const (
+	Default_Team_Score = int32(0)
+)
+
+func (x *Team) Reset() {
+	*x = Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Team) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return Default_Team_Score
+}
+
+func (x *Team) GetPlayerIds() []int32 {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+// Статистика игрока за игру (расширение протокола)
+type PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FoodEaten     *int32                    `protobuf:"varint,1,opt,name=food_eaten,json=foodEaten,def=0" json:"food_eaten,omitempty"`             // Сколько еды съела змея
+	Kills         *int32                    `protobuf:"varint,2,opt,name=kills,def=0" json:"kills,omitempty"`                                      // Сколько змей врезалось в змею игрока
+	Deaths        *int32                    `protobuf:"varint,3,opt,name=deaths,def=0" json:"deaths,omitempty"`                                    // Сколько раз змея погибала
+	DeathCauses   []*PlayerStats_DeathCount `protobuf:"bytes,4,rep,name=death_causes,json=deathCauses" json:"death_causes,omitempty"`              // Причины гибели змеи
+	MaxLength     *int32                    `protobuf:"varint,5,opt,name=max_length,json=maxLength,def=0" json:"max_length,omitempty"`             // Наибольшая длина змеи
+	TicksSurvived *int32                    `protobuf:"varint,6,opt,name=ticks_survived,json=ticksSurvived,def=0" json:"ticks_survived,omitempty"` // Сколько ходов змея была жива
+	Turns         *int32                    `protobuf:"varint,7,opt,name=turns,def=0" json:"turns,omitempty"`                                      // Сколько раз змея поворачивала
+}
+
+// Default values for PlayerStats fields.
+const (
+	Default_PlayerStats_FoodEaten     = int32(0)
+	Default_PlayerStats_Kills         = int32(0)
+	Default_PlayerStats_Deaths        = int32(0)
+	Default_PlayerStats_MaxLength     = int32(0)
+	Default_PlayerStats_TicksSurvived = int32(0)
+	Default_PlayerStats_Turns         = int32(0)
+)
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlayerStats) GetFoodEaten() int32 {
+	if x != nil && x.FoodEaten != nil {
+		return *x.FoodEaten
+	}
+	return Default_PlayerStats_FoodEaten
+}
+
+func (x *PlayerStats) GetKills() int32 {
+	if x != nil && x.Kills != nil {
+		return *x.Kills
+	}
+	return Default_PlayerStats_Kills
+}
+
+func (x *PlayerStats) GetDeaths() int32 {
+	if x != nil && x.Deaths != nil {
+		return *x.Deaths
+	}
+	return Default_PlayerStats_Deaths
+}
+
+func (x *PlayerStats) GetDeathCauses() []*PlayerStats_DeathCount {
+	if x != nil {
+		return x.DeathCauses
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetMaxLength() int32 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
+	}
+	return Default_PlayerStats_MaxLength
+}
+
+func (x *PlayerStats) GetTicksSurvived() int32 {
+	if x != nil && x.TicksSurvived != nil {
+		return *x.TicksSurvived
+	}
+	return Default_PlayerStats_TicksSurvived
+}
+
+func (x *PlayerStats) GetTurns() int32 {
+	if x != nil && x.Turns != nil {
+		return *x.Turns
+	}
+	return Default_PlayerStats_Turns
+}
+
+// Параметры идущей игры (не должны меняться в процессе игры)
+type GameConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width        *int32             `protobuf:"varint,1,opt,name=width,def=40" json:"width,omitempty"`                                       // Ширина поля в клетках (от 10 до 100)
+	Height       *int32             `protobuf:"varint,2,opt,name=height,def=30" json:"height,omitempty"`                                     // Высота поля в клетках (от 10 до 100)
+	FoodStatic   *int32             `protobuf:"varint,3,opt,name=food_static,json=foodStatic,def=1" json:"food_static,omitempty"`            // Количество клеток с едой, независимо от числа игроков (от 0 до 100)
+	StateDelayMs *int32             `protobuf:"varint,5,opt,name=state_delay_ms,json=stateDelayMs,def=1000" json:"state_delay_ms,omitempty"` // Задержка между ходами (сменой состояний) в игре, в миллисекундах (от 100 до 3000)
+	Walled       *bool              `protobuf:"varint,6,opt,name=walled,def=0" json:"walled,omitempty"`                                      // Края поля являются стенами, иначе поле замкнуто в тор (расширение протокола)
+	Walls        []*GameState_Coord `protobuf:"bytes,7,rep,name=walls" json:"walls,omitempty"`                                               // Клетки стен внутри поля (расширение протокола)
+	SpawnPoints  []*GameState_Coord `protobuf:"bytes,8,rep,name=spawn_points,json=spawnPoints" json:"spawn_points,omitempty"`                // Предпочтительные клетки для головы новой змеи (расширение протокола)
+	FoodSpots    []*GameState_Coord `protobuf:"bytes,9,rep,name=food_spots,json=foodSpots" json:"food_spots,omitempty"`                      // Клетки, в которых еда появляется всякий раз, когда они пусты (расширение протокола)
+	// Условия окончания игры (расширение протокола, 0 или false - условие не проверяется)
+	MaxTurns          *int32 `protobuf:"varint,10,opt,name=max_turns,json=maxTurns,def=0" json:"max_turns,omitempty"`                              // Длительность игры в ходах
+	TargetScore       *int32 `protobuf:"varint,11,opt,name=target_score,json=targetScore,def=0" json:"target_score,omitempty"`                     // Число очков, набрав которое игрок побеждает
+	LastSnakeStanding *bool  `protobuf:"varint,12,opt,name=last_snake_standing,json=lastSnakeStanding,def=0" json:"last_snake_standing,omitempty"` // Игра заканчивается, когда в живых остаётся одна змея
+	MaxItems          *int32 `protobuf:"varint,13,opt,name=max_items,json=maxItems,def=0" json:"max_items,omitempty"`                              // Наибольшее число бонусов на поле, 0 - бонусов нет (расширение протокола)
+	// Относительные частоты появления видов еды (расширение протокола)
+	NormalFoodWeight *int32 `protobuf:"varint,14,opt,name=normal_food_weight,json=normalFoodWeight,def=1" json:"normal_food_weight,omitempty"`
+	GoldenFoodWeight *int32 `protobuf:"varint,15,opt,name=golden_food_weight,json=goldenFoodWeight,def=0" json:"golden_food_weight,omitempty"`
+	PoisonFoodWeight *int32 `protobuf:"varint,16,opt,name=poison_food_weight,json=poisonFoodWeight,def=0" json:"poison_food_weight,omitempty"`
+	// Возрождение змей (расширение протокола)
+	RespawnDelay        *int32 `protobuf:"varint,17,opt,name=respawn_delay,json=respawnDelay,def=0" json:"respawn_delay,omitempty"`                          // Через сколько ходов игрок получает новую змею, 0 - змеи не возрождаются
+	HalveScoreOnRespawn *bool  `protobuf:"varint,18,opt,name=halve_score_on_respawn,json=halveScoreOnRespawn,def=0" json:"halve_score_on_respawn,omitempty"` // При возрождении очки игрока делятся пополам
+	ShrinkInterval      *int32 `protobuf:"varint,19,opt,name=shrink_interval,json=shrinkInterval,def=0" json:"shrink_interval,omitempty"`                    // Через сколько ходов очередное кольцо клеток у края поля становится стенами, 0 - поле не сужается (расширение протокола)
+	// Командная игра (расширение протокола)
+	TeamCount     *int32 `protobuf:"varint,20,opt,name=team_count,json=teamCount,def=0" json:"team_count,omitempty"`             // Число команд, 0 - игра без команд
+	SafeTeammates *bool  `protobuf:"varint,21,opt,name=safe_teammates,json=safeTeammates,def=0" json:"safe_teammates,omitempty"` // Змея проходит сквозь тела змей своей команды, не погибая
+	SpawnDistance *int32 `protobuf:"varint,22,opt,name=spawn_distance,json=spawnDistance,def=4" json:"spawn_distance,omitempty"` // Новая змея появляется не ближе этого расстояния к головам других змей и не на их пути (расширение протокола)
+}
+
+// Default values for GameConfig fields.
+const (
+	Default_GameConfig_Width               = int32(40)
+	Default_GameConfig_Height              = int32(30)
+	Default_GameConfig_FoodStatic          = int32(1)
+	Default_GameConfig_StateDelayMs        = int32(1000)
+	Default_GameConfig_Walled              = bool(false)
+	Default_GameConfig_MaxTurns            = int32(0)
+	Default_GameConfig_TargetScore         = int32(0)
+	Default_GameConfig_LastSnakeStanding   = bool(false)
+	Default_GameConfig_MaxItems            = int32(0)
+	Default_GameConfig_NormalFoodWeight    = int32(1)
+	Default_GameConfig_GoldenFoodWeight    = int32(0)
+	Default_GameConfig_PoisonFoodWeight    = int32(0)
+	Default_GameConfig_RespawnDelay        = int32(0)
+	Default_GameConfig_HalveScoreOnRespawn = bool(false)
+	Default_GameConfig_ShrinkInterval      = int32(0)
+	Default_GameConfig_TeamCount           = int32(0)
+	Default_GameConfig_SafeTeammates       = bool(false)
+	Default_GameConfig_SpawnDistance       = int32(4)
+)
+
+func (x *GameConfig) Reset() {
+	*x = GameConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameConfig) ProtoMessage() {}
+
+func (x *GameConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameConfig.ProtoReflect.Descriptor instead.
+func (*GameConfig) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GameConfig) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return Default_GameConfig_Width
+}
+
+func (x *GameConfig) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return Default_GameConfig_Height
+}
+
+func (x *GameConfig) GetFoodStatic() int32 {
+	if x != nil && x.FoodStatic != nil {
+		return *x.FoodStatic
+	}
+	return Default_GameConfig_FoodStatic
+}
+
+func (x *GameConfig) GetStateDelayMs() int32 {
+	if x != nil && x.StateDelayMs != nil {
+		return *x.StateDelayMs
+	}
+	return Default_GameConfig_StateDelayMs
+}
+
+func (x *GameConfig) GetWalled() bool {
+	if x != nil && x.Walled != nil {
+		return *x.Walled
+	}
+	return Default_GameConfig_Walled
+}
+
+func (x *GameConfig) GetWalls() []*GameState_Coord {
+	if x != nil {
+		return x.Walls
+	}
+	return nil
+}
+
+func (x *GameConfig) GetSpawnPoints() []*GameState_Coord {
+	if x != nil {
+		return x.SpawnPoints
+	}
+	return nil
+}
+
+func (x *GameConfig) GetFoodSpots() []*GameState_Coord {
+	if x != nil {
+		return x.FoodSpots
+	}
+	return nil
+}
+
+func (x *GameConfig) GetMaxTurns() int32 {
+	if x != nil && x.MaxTurns != nil {
+		return *x.MaxTurns
+	}
+	return Default_GameConfig_MaxTurns
+}
+
+func (x *GameConfig) GetTargetScore() int32 {
+	if x != nil && x.TargetScore != nil {
+		return *x.TargetScore
+	}
+	return Default_GameConfig_TargetScore
+}
+
+func (x *GameConfig) GetLastSnakeStanding() bool {
+	if x != nil && x.LastSnakeStanding != nil {
+		return *x.LastSnakeStanding
+	}
+	return Default_GameConfig_LastSnakeStanding
+}
+
+func (x *GameConfig) GetMaxItems() int32 {
+	if x != nil && x.MaxItems != nil {
+		return *x.MaxItems
+	}
+	return Default_GameConfig_MaxItems
+}
+
+func (x *GameConfig) GetNormalFoodWeight() int32 {
+	if x != nil && x.NormalFoodWeight != nil {
+		return *x.NormalFoodWeight
+	}
+	return Default_GameConfig_NormalFoodWeight
+}
+
+func (x *GameConfig) GetGoldenFoodWeight() int32 {
+	if x != nil && x.GoldenFoodWeight != nil {
+		return *x.GoldenFoodWeight
+	}
+	return Default_GameConfig_GoldenFoodWeight
+}
+
+func (x *GameConfig) GetPoisonFoodWeight() int32 {
+	if x != nil && x.PoisonFoodWeight != nil {
+		return *x.PoisonFoodWeight
+	}
+	return Default_GameConfig_PoisonFoodWeight
+}
+
+func (x *GameConfig) GetRespawnDelay() int32 {
+	if x != nil && x.RespawnDelay != nil {
+		return *x.RespawnDelay
+	}
+	return Default_GameConfig_RespawnDelay
+}
+
+func (x *GameConfig) GetHalveScoreOnRespawn() bool {
+	if x != nil && x.HalveScoreOnRespawn != nil {
+		return *x.HalveScoreOnRespawn
+	}
+	return Default_GameConfig_HalveScoreOnRespawn
+}
+
+func (x *GameConfig) GetShrinkInterval() int32 {
+	if x != nil && x.ShrinkInterval != nil {
+		return *x.ShrinkInterval
+	}
+	return Default_GameConfig_ShrinkInterval
+}
+
+func (x *GameConfig) GetTeamCount() int32 {
+	if x != nil && x.TeamCount != nil {
+		return *x.TeamCount
+	}
+	return Default_GameConfig_TeamCount
+}
+
+func (x *GameConfig) GetSafeTeammates() bool {
+	if x != nil && x.SafeTeammates != nil {
+		return *x.SafeTeammates
+	}
+	return Default_GameConfig_SafeTeammates
+}
+
+func (x *GameConfig) GetSpawnDistance() int32 {
+	if x != nil && x.SpawnDistance != nil {
+		return *x.SpawnDistance
+	}
+	return Default_GameConfig_SpawnDistance
+}
+
+// Игроки конкретной игры
+type GamePlayers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*GamePlayer `protobuf:"bytes,1,rep,name=players" json:"players,omitempty"` // Список всех игроков
+}
+
+func (x *GamePlayers) Reset() {
+	*x = GamePlayers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GamePlayers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamePlayers) ProtoMessage() {}
+
+func (x *GamePlayers) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamePlayers.ProtoReflect.Descriptor instead.
+func (*GamePlayers) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GamePlayers) GetPlayers() []*GamePlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// Текущее состояние игрового поля
+type GameState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StateOrder *int32             `protobuf:"varint,1,req,name=state_order,json=stateOrder" json:"state_order,omitempty"` // Порядковый номер состояния, уникален в пределах игры, монотонно возрастает
+	Snakes     []*GameState_Snake `protobuf:"bytes,2,rep,name=snakes" json:"snakes,omitempty"`                            // Список змей
+	Foods      []*GameState_Coord `protobuf:"bytes,3,rep,name=foods" json:"foods,omitempty"`                              // Список клеток с едой
+	Players    *GamePlayers       `protobuf:"bytes,4,req,name=players" json:"players,omitempty"`                          // Актуальнейший список игроков
+	Events     []*GameEvent       `protobuf:"bytes,5,rep,name=events" json:"events,omitempty"`                            // События последнего хода (расширение протокола, может игнорироваться)
+	Result     *GameResult        `protobuf:"bytes,6,opt,name=result" json:"result,omitempty"`                            // Результат игры, есть только у законченной игры (расширение протокола)
+	// Раунды (расширение протокола)
+	Round           *int32               `protobuf:"varint,7,opt,name=round,def=1" json:"round,omitempty"`                                                 // Номер текущего раунда
+	RoundStartOrder *int32               `protobuf:"varint,8,opt,name=round_start_order,json=roundStartOrder,def=0" json:"round_start_order,omitempty"`    // Номер состояния, с которого начался текущий раунд
+	Items           []*GameState_Item    `protobuf:"bytes,9,rep,name=items" json:"items,omitempty"`                                                        // Список бонусов на поле (расширение протокола)
+	FoodKinds       []GameState_FoodKind `protobuf:"varint,10,rep,name=food_kinds,json=foodKinds,enum=p2p.GameState_FoodKind" json:"food_kinds,omitempty"` // Виды еды в порядке списка foods, отсутствующий вид - NORMAL_FOOD (расширение протокола)
+	// Сужение поля (расширение протокола)
+	ArenaLevel      *int32  `protobuf:"varint,11,opt,name=arena_level,json=arenaLevel,def=0" json:"arena_level,omitempty"`                  // Сколько колец клеток у края поля стали стенами
+	NextShrinkOrder *int32  `protobuf:"varint,12,opt,name=next_shrink_order,json=nextShrinkOrder,def=0" json:"next_shrink_order,omitempty"` // Номер состояния, в котором следующее кольцо станет стенами, 0 - поле больше не сужается
+	Teams           []*Team `protobuf:"bytes,13,rep,name=teams" json:"teams,omitempty"`                                                     // Команды с набранными очками (расширение протокола)
+}
+
+// Default values for GameState fields.
 const (
-	Default_GamePlayer_Type = PlayerType_HUMAN
+	Default_GameState_Round           = int32(1)
+	Default_GameState_RoundStartOrder = int32(0)
+	Default_GameState_ArenaLevel      = int32(0)
+	Default_GameState_NextShrinkOrder = int32(0)
 )
 
-func (x *GamePlayer) Reset() {
-	*x = GamePlayer{}
+func (x *GameState) Reset() {
+	*x = GameState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[0]
+		mi := &file_p2p_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GamePlayer) String() string {
+func (x *GameState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GamePlayer) ProtoMessage() {}
+func (*GameState) ProtoMessage() {}
 
-func (x *GamePlayer) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[0]
+func (x *GameState) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,166 +1242,129 @@ func (x *GamePlayer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GamePlayer.ProtoReflect.Descriptor instead.
-func (*GamePlayer) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *GamePlayer) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GamePlayer) GetId() int32 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *GameState) GetStateOrder() int32 {
+	if x != nil && x.StateOrder != nil {
+		return *x.StateOrder
 	}
 	return 0
 }
 
-func (x *GamePlayer) GetIpAddress() string {
-	if x != nil && x.IpAddress != nil {
-		return *x.IpAddress
+func (x *GameState) GetSnakes() []*GameState_Snake {
+	if x != nil {
+		return x.Snakes
 	}
-	return ""
+	return nil
 }
 
-func (x *GamePlayer) GetPort() int32 {
-	if x != nil && x.Port != nil {
-		return *x.Port
+func (x *GameState) GetFoods() []*GameState_Coord {
+	if x != nil {
+		return x.Foods
 	}
-	return 0
+	return nil
 }
 
-func (x *GamePlayer) GetRole() NodeRole {
-	if x != nil && x.Role != nil {
-		return *x.Role
+func (x *GameState) GetPlayers() *GamePlayers {
+	if x != nil {
+		return x.Players
 	}
-	return NodeRole_NORMAL
+	return nil
 }
 
-func (x *GamePlayer) GetType() PlayerType {
-	if x != nil && x.Type != nil {
-		return *x.Type
+func (x *GameState) GetEvents() []*GameEvent {
+	if x != nil {
+		return x.Events
 	}
-	return Default_GamePlayer_Type
+	return nil
 }
 
-func (x *GamePlayer) GetScore() int32 {
-	if x != nil && x.Score != nil {
-		return *x.Score
+func (x *GameState) GetResult() *GameResult {
+	if x != nil {
+		return x.Result
 	}
-	return 0
-}
-
-// Параметры идущей игры (не должны меняться в процессе игры)
-type GameConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Width        *int32 `protobuf:"varint,1,opt,name=width,def=40" json:"width,omitempty"`                                       // Ширина поля в клетках (от 10 до 100)
-	Height       *int32 `protobuf:"varint,2,opt,name=height,def=30" json:"height,omitempty"`                                     // Высота поля в клетках (от 10 до 100)
-	FoodStatic   *int32 `protobuf:"varint,3,opt,name=food_static,json=foodStatic,def=1" json:"food_static,omitempty"`            // Количество клеток с едой, независимо от числа игроков (от 0 до 100)
-	StateDelayMs *int32 `protobuf:"varint,5,opt,name=state_delay_ms,json=stateDelayMs,def=1000" json:"state_delay_ms,omitempty"` // Задержка между ходами (сменой состояний) в игре, в миллисекундах (от 100 до 3000)
+	return nil
 }
 
-// Default values for GameConfig fields.
-const (
-	Default_GameConfig_Width        = int32(40)
-	Default_GameConfig_Height       = int32(30)
-	Default_GameConfig_FoodStatic   = int32(1)
-	Default_GameConfig_StateDelayMs = int32(1000)
-)
-
-func (x *GameConfig) Reset() {
-	*x = GameConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GameState) GetRound() int32 {
+	if x != nil && x.Round != nil {
+		return *x.Round
 	}
+	return Default_GameState_Round
 }
 
-func (x *GameConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GameConfig) ProtoMessage() {}
-
-func (x *GameConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GameState) GetRoundStartOrder() int32 {
+	if x != nil && x.RoundStartOrder != nil {
+		return *x.RoundStartOrder
 	}
-	return mi.MessageOf(x)
+	return Default_GameState_RoundStartOrder
 }
 
-// Deprecated: Use GameConfig.ProtoReflect.Descriptor instead.
-func (*GameConfig) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{1}
+func (x *GameState) GetItems() []*GameState_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
 }
 
-func (x *GameConfig) GetWidth() int32 {
-	if x != nil && x.Width != nil {
-		return *x.Width
+func (x *GameState) GetFoodKinds() []GameState_FoodKind {
+	if x != nil {
+		return x.FoodKinds
 	}
-	return Default_GameConfig_Width
+	return nil
 }
 
-func (x *GameConfig) GetHeight() int32 {
-	if x != nil && x.Height != nil {
-		return *x.Height
+func (x *GameState) GetArenaLevel() int32 {
+	if x != nil && x.ArenaLevel != nil {
+		return *x.ArenaLevel
 	}
-	return Default_GameConfig_Height
+	return Default_GameState_ArenaLevel
 }
 
-func (x *GameConfig) GetFoodStatic() int32 {
-	if x != nil && x.FoodStatic != nil {
-		return *x.FoodStatic
+func (x *GameState) GetNextShrinkOrder() int32 {
+	if x != nil && x.NextShrinkOrder != nil {
+		return *x.NextShrinkOrder
 	}
-	return Default_GameConfig_FoodStatic
+	return Default_GameState_NextShrinkOrder
 }
 
-func (x *GameConfig) GetStateDelayMs() int32 {
-	if x != nil && x.StateDelayMs != nil {
-		return *x.StateDelayMs
+func (x *GameState) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
 	}
-	return Default_GameConfig_StateDelayMs
+	return nil
 }
 
-// Игроки конкретной игры
-type GamePlayers struct {
+// Результат законченной игры (расширение протокола)
+type GameResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Players []*GamePlayer `protobuf:"bytes,1,rep,name=players" json:"players,omitempty"` // Список всех игроков
+	Reason   *GameResult_FinishReason `protobuf:"varint,1,req,name=reason,enum=p2p.GameResult_FinishReason" json:"reason,omitempty"`
+	WinnerId *int32                   `protobuf:"varint,2,opt,name=winner_id,json=winnerId" json:"winner_id,omitempty"` // Идентификатор победителя, отсутствует при ничьей
 }
 
-func (x *GamePlayers) Reset() {
-	*x = GamePlayers{}
+func (x *GameResult) Reset() {
+	*x = GameResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[2]
+		mi := &file_p2p_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GamePlayers) String() string {
+func (x *GameResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GamePlayers) ProtoMessage() {}
+func (*GameResult) ProtoMessage() {}
 
-func (x *GamePlayers) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[2]
+func (x *GameResult) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,47 +1375,57 @@ func (x *GamePlayers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GamePlayers.ProtoReflect.Descriptor instead.
-func (*GamePlayers) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GameResult.ProtoReflect.Descriptor instead.
+func (*GameResult) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GamePlayers) GetPlayers() []*GamePlayer {
-	if x != nil {
-		return x.Players
+func (x *GameResult) GetReason() GameResult_FinishReason {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
-	return nil
+	return GameResult_TURN_LIMIT
 }
 
-// Текущее состояние игрового поля
-type GameState struct {
+func (x *GameResult) GetWinnerId() int32 {
+	if x != nil && x.WinnerId != nil {
+		return *x.WinnerId
+	}
+	return 0
+}
+
+// Событие, произошедшее на ходу (расширение протокола)
+type GameEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StateOrder *int32             `protobuf:"varint,1,req,name=state_order,json=stateOrder" json:"state_order,omitempty"` // Порядковый номер состояния, уникален в пределах игры, монотонно возрастает
-	Snakes     []*GameState_Snake `protobuf:"bytes,2,rep,name=snakes" json:"snakes,omitempty"`                            // Список змей
-	Foods      []*GameState_Coord `protobuf:"bytes,3,rep,name=foods" json:"foods,omitempty"`                              // Список клеток с едой
-	Players    *GamePlayers       `protobuf:"bytes,4,req,name=players" json:"players,omitempty"`                          // Актуальнейший список игроков
+	Type      *GameEvent_EventType  `protobuf:"varint,1,req,name=type,enum=p2p.GameEvent_EventType" json:"type,omitempty"`
+	PlayerId  *int32                `protobuf:"varint,2,opt,name=player_id,json=playerId" json:"player_id,omitempty"`         // Идентификатор игрока, к которому относится событие (победителя для GAME_FINISHED)
+	Coord     *GameState_Coord      `protobuf:"bytes,3,opt,name=coord" json:"coord,omitempty"`                                // Клетка, в которой произошло событие
+	Cause     *GameEvent_DeathCause `protobuf:"varint,4,opt,name=cause,enum=p2p.GameEvent_DeathCause" json:"cause,omitempty"` // Причина гибели (только для SNAKE_DIED)
+	KillerIds []int32               `protobuf:"varint,5,rep,name=killer_ids,json=killerIds" json:"killer_ids,omitempty"`      // Идентификаторы змей, в которые врезались (только для SNAKE_DIED)
+	Points    *int32                `protobuf:"varint,6,opt,name=points" json:"points,omitempty"`                             // Число полученных очков (только для POINTS_AWARDED)
+	Item      *GameState_Item_Kind  `protobuf:"varint,7,opt,name=item,enum=p2p.GameState_Item_Kind" json:"item,omitempty"`    // Вид бонуса (только для ITEM_SPAWNED и ITEM_PICKED_UP)
 }
 
-func (x *GameState) Reset() {
-	*x = GameState{}
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[3]
+		mi := &file_p2p_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameState) String() string {
+func (x *GameEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameState) ProtoMessage() {}
+func (*GameEvent) ProtoMessage() {}
 
-func (x *GameState) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[3]
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,39 +1436,60 @@ func (x *GameState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
-func (*GameState) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GameState) GetStateOrder() int32 {
-	if x != nil && x.StateOrder != nil {
-		return *x.StateOrder
+func (x *GameEvent) GetType() GameEvent_EventType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return GameEvent_FOOD_EATEN
+}
+
+func (x *GameEvent) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
 	return 0
 }
 
-func (x *GameState) GetSnakes() []*GameState_Snake {
+func (x *GameEvent) GetCoord() *GameState_Coord {
 	if x != nil {
-		return x.Snakes
+		return x.Coord
 	}
 	return nil
 }
 
-func (x *GameState) GetFoods() []*GameState_Coord {
-	if x != nil {
-		return x.Foods
+func (x *GameEvent) GetCause() GameEvent_DeathCause {
+	if x != nil && x.Cause != nil {
+		return *x.Cause
 	}
-	return nil
+	return GameEvent_SELF
 }
 
-func (x *GameState) GetPlayers() *GamePlayers {
+func (x *GameEvent) GetKillerIds() []int32 {
 	if x != nil {
-		return x.Players
+		return x.KillerIds
 	}
 	return nil
 }
 
+func (x *GameEvent) GetPoints() int32 {
+	if x != nil && x.Points != nil {
+		return *x.Points
+	}
+	return 0
+}
+
+func (x *GameEvent) GetItem() GameState_Item_Kind {
+	if x != nil && x.Item != nil {
+		return *x.Item
+	}
+	return GameState_Item_SPEED_BOOST
+}
+
 type GameAnnouncement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -570,6 +1499,7 @@ type GameAnnouncement struct {
 	Config   *GameConfig  `protobuf:"bytes,2,req,name=config" json:"config,omitempty"`                         // Параметры игры
 	CanJoin  *bool        `protobuf:"varint,3,opt,name=can_join,json=canJoin,def=1" json:"can_join,omitempty"` // Можно ли новому игроку присоединиться к игре (есть ли место на поле)
 	GameName *string      `protobuf:"bytes,4,req,name=game_name,json=gameName" json:"game_name,omitempty"`     // Глобально уникальное имя игры, например "my game"
+	Teams    []*Team      `protobuf:"bytes,5,rep,name=teams" json:"teams,omitempty"`                           // Составы команд (расширение протокола)
 }
 
 // Default values for GameAnnouncement fields.
@@ -580,7 +1510,7 @@ const (
 func (x *GameAnnouncement) Reset() {
 	*x = GameAnnouncement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[4]
+		mi := &file_p2p_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -593,7 +1523,7 @@ func (x *GameAnnouncement) String() string {
 func (*GameAnnouncement) ProtoMessage() {}
 
 func (x *GameAnnouncement) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[4]
+	mi := &file_p2p_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +1536,7 @@ func (x *GameAnnouncement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameAnnouncement.ProtoReflect.Descriptor instead.
 func (*GameAnnouncement) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{4}
+	return file_p2p_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GameAnnouncement) GetPlayers() *GamePlayers {
@@ -637,6 +1567,13 @@ func (x *GameAnnouncement) GetGameName() string {
 	return ""
 }
 
+func (x *GameAnnouncement) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
 // Общий формат любого UDP-сообщения
 type GameMessage struct {
 	state         protoimpl.MessageState
@@ -664,7 +1601,7 @@ type GameMessage struct {
 func (x *GameMessage) Reset() {
 	*x = GameMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[5]
+		mi := &file_p2p_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -677,7 +1614,7 @@ func (x *GameMessage) String() string {
 func (*GameMessage) ProtoMessage() {}
 
 func (x *GameMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[5]
+	mi := &file_p2p_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,7 +1627,7 @@ func (x *GameMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMessage.ProtoReflect.Descriptor instead.
 func (*GameMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5}
+	return file_p2p_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GameMessage) GetMsgSeq() int64 {
@@ -801,7 +1738,7 @@ type GameMessage_Ack struct {
 }
 
 type GameMessage_State struct {
-	State *GameMessage_StateMsg `protobuf:"bytes,5,opt,name=game,oneof"`
+	State *GameMessage_StateMsg `protobuf:"bytes,5,opt,name=state,oneof"`
 }
 
 type GameMessage_Announcement struct {
@@ -842,6 +1779,62 @@ func (*GameMessage_RoleChange) isGameMessage_Type() {}
 
 func (*GameMessage_Discover) isGameMessage_Type() {}
 
+// Число гибелей змеи по одной причине
+type PlayerStats_DeathCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cause *GameEvent_DeathCause `protobuf:"varint,1,req,name=cause,enum=p2p.GameEvent_DeathCause" json:"cause,omitempty"`
+	Count *int32                `protobuf:"varint,2,req,name=count" json:"count,omitempty"`
+}
+
+func (x *PlayerStats_DeathCount) Reset() {
+	*x = PlayerStats_DeathCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats_DeathCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats_DeathCount) ProtoMessage() {}
+
+func (x *PlayerStats_DeathCount) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats_DeathCount.ProtoReflect.Descriptor instead.
+func (*PlayerStats_DeathCount) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *PlayerStats_DeathCount) GetCause() GameEvent_DeathCause {
+	if x != nil && x.Cause != nil {
+		return *x.Cause
+	}
+	return GameEvent_SELF
+}
+
+func (x *PlayerStats_DeathCount) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
 // Координаты в пределах игрового поля, либо относительное смещение координат.
 // Левая верхняя клетка поля имеет координаты (x=0, y=0).
 // Направление смещения задаётся знаком чисел.
@@ -863,7 +1856,7 @@ const (
 func (x *GameState_Coord) Reset() {
 	*x = GameState_Coord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[6]
+		mi := &file_p2p_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -876,7 +1869,7 @@ func (x *GameState_Coord) String() string {
 func (*GameState_Coord) ProtoMessage() {}
 
 func (x *GameState_Coord) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[6]
+	mi := &file_p2p_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,7 +1882,7 @@ func (x *GameState_Coord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameState_Coord.ProtoReflect.Descriptor instead.
 func (*GameState_Coord) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{3, 0}
+	return file_p2p_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *GameState_Coord) GetX() int32 {
@@ -912,24 +1905,34 @@ type GameState_Snake struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerId *int32 `protobuf:"varint,1,req,name=player_id,json=nextPlayerId" json:"player_id,omitempty"` // Идентификатор игрока-владельца змеи, см. GamePlayer.playerid
+	PlayerId *int32 `protobuf:"varint,1,req,name=player_id,json=playerId" json:"player_id,omitempty"` // Идентификатор игрока-владельца змеи, см. GamePlayer.id
 	// Список "ключевых" точек змеи. Первая точка хранит координаты головы змеи.
 	// Каждая следующая - смещение следующей "ключевой" точки относительно предыдущей,
 	// в частности последняя точка хранит смещение хвоста змеи относительно предыдущей "ключевой" точки.
 	Points        []*GameState_Coord          `protobuf:"bytes,2,rep,name=points" json:"points,omitempty"`
-	State         *GameState_Snake_SnakeState `protobuf:"varint,3,req,name=game,enum=p2p.GameState_Snake_SnakeState,def=0" json:"game,omitempty"`                 // статус змеи в игре
+	State         *GameState_Snake_SnakeState `protobuf:"varint,3,req,name=state,enum=p2p.GameState_Snake_SnakeState,def=0" json:"state,omitempty"`               // статус змеи в игре
 	HeadDirection *Direction                  `protobuf:"varint,4,req,name=head_direction,json=headDirection,enum=p2p.Direction" json:"head_direction,omitempty"` // Направление, в котором "повёрнута" голова змейки в текущий момент
+	// Данные вышедшего игрока, который может вернуть себе змею-зомби (расширение протокола)
+	OwnerName       *string             `protobuf:"bytes,5,opt,name=owner_name,json=ownerName" json:"owner_name,omitempty"`                    // Имя вышедшего игрока
+	OwnerScore      *int32              `protobuf:"varint,6,opt,name=owner_score,json=ownerScore" json:"owner_score,omitempty"`                // Число очков вышедшего игрока
+	OwnerTokenHash  *string             `protobuf:"bytes,7,opt,name=owner_token_hash,json=ownerTokenHash" json:"owner_token_hash,omitempty"`   // Хэш токена для возвращения в игру
+	ReclaimDeadline *int32              `protobuf:"varint,8,opt,name=reclaim_deadline,json=reclaimDeadline" json:"reclaim_deadline,omitempty"` // Последний номер состояния, до которого змею можно вернуть
+	Effects         []*GameState_Effect `protobuf:"bytes,9,rep,name=effects" json:"effects,omitempty"`                                         // Действующие эффекты подобранных бонусов (расширение протокола)
+	Growth          *int32              `protobuf:"varint,10,opt,name=growth,def=0" json:"growth,omitempty"`                                   // На сколько клеток змее ещё предстоит вырасти (расширение протокола)
+	OwnerTeam       *int32              `protobuf:"varint,11,opt,name=owner_team,json=ownerTeam" json:"owner_team,omitempty"`                  // Команда вышедшего игрока (расширение протокола)
+	OwnerStats      *PlayerStats        `protobuf:"bytes,12,opt,name=owner_stats,json=ownerStats" json:"owner_stats,omitempty"`                // Статистика вышедшего игрока (расширение протокола)
 }
 
 // Default values for GameState_Snake fields.
 const (
-	Default_GameState_Snake_State = GameState_Snake_ALIVE
+	Default_GameState_Snake_State  = GameState_Snake_ALIVE
+	Default_GameState_Snake_Growth = int32(0)
 )
 
 func (x *GameState_Snake) Reset() {
 	*x = GameState_Snake{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[7]
+		mi := &file_p2p_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -942,7 +1945,7 @@ func (x *GameState_Snake) String() string {
 func (*GameState_Snake) ProtoMessage() {}
 
 func (x *GameState_Snake) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[7]
+	mi := &file_p2p_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,7 +1958,7 @@ func (x *GameState_Snake) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameState_Snake.ProtoReflect.Descriptor instead.
 func (*GameState_Snake) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{3, 1}
+	return file_p2p_proto_rawDescGZIP(), []int{5, 1}
 }
 
 func (x *GameState_Snake) GetPlayerId() int32 {
@@ -986,6 +1989,174 @@ func (x *GameState_Snake) GetHeadDirection() Direction {
 	return Direction_UP
 }
 
+func (x *GameState_Snake) GetOwnerName() string {
+	if x != nil && x.OwnerName != nil {
+		return *x.OwnerName
+	}
+	return ""
+}
+
+func (x *GameState_Snake) GetOwnerScore() int32 {
+	if x != nil && x.OwnerScore != nil {
+		return *x.OwnerScore
+	}
+	return 0
+}
+
+func (x *GameState_Snake) GetOwnerTokenHash() string {
+	if x != nil && x.OwnerTokenHash != nil {
+		return *x.OwnerTokenHash
+	}
+	return ""
+}
+
+func (x *GameState_Snake) GetReclaimDeadline() int32 {
+	if x != nil && x.ReclaimDeadline != nil {
+		return *x.ReclaimDeadline
+	}
+	return 0
+}
+
+func (x *GameState_Snake) GetEffects() []*GameState_Effect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+func (x *GameState_Snake) GetGrowth() int32 {
+	if x != nil && x.Growth != nil {
+		return *x.Growth
+	}
+	return Default_GameState_Snake_Growth
+}
+
+func (x *GameState_Snake) GetOwnerTeam() int32 {
+	if x != nil && x.OwnerTeam != nil {
+		return *x.OwnerTeam
+	}
+	return 0
+}
+
+func (x *GameState_Snake) GetOwnerStats() *PlayerStats {
+	if x != nil {
+		return x.OwnerStats
+	}
+	return nil
+}
+
+// Бонус на поле (расширение протокола)
+type GameState_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind  *GameState_Item_Kind `protobuf:"varint,1,req,name=kind,enum=p2p.GameState_Item_Kind" json:"kind,omitempty"`
+	Coord *GameState_Coord     `protobuf:"bytes,2,req,name=coord" json:"coord,omitempty"`
+}
+
+func (x *GameState_Item) Reset() {
+	*x = GameState_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameState_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState_Item) ProtoMessage() {}
+
+func (x *GameState_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState_Item.ProtoReflect.Descriptor instead.
+func (*GameState_Item) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *GameState_Item) GetKind() GameState_Item_Kind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return GameState_Item_SPEED_BOOST
+}
+
+func (x *GameState_Item) GetCoord() *GameState_Coord {
+	if x != nil {
+		return x.Coord
+	}
+	return nil
+}
+
+// Действующий эффект бонуса (расширение протокола)
+type GameState_Effect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     *GameState_Item_Kind `protobuf:"varint,1,req,name=kind,enum=p2p.GameState_Item_Kind" json:"kind,omitempty"`
+	Deadline *int32               `protobuf:"varint,2,req,name=deadline" json:"deadline,omitempty"` // Последний номер состояния, в котором эффект действует
+}
+
+func (x *GameState_Effect) Reset() {
+	*x = GameState_Effect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameState_Effect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState_Effect) ProtoMessage() {}
+
+func (x *GameState_Effect) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState_Effect.ProtoReflect.Descriptor instead.
+func (*GameState_Effect) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{5, 3}
+}
+
+func (x *GameState_Effect) GetKind() GameState_Item_Kind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return GameState_Item_SPEED_BOOST
+}
+
+func (x *GameState_Effect) GetDeadline() int32 {
+	if x != nil && x.Deadline != nil {
+		return *x.Deadline
+	}
+	return 0
+}
+
 // Ничего не меняем, просто говорим, что мы живы
 type GameMessage_PingMsg struct {
 	state         protoimpl.MessageState
@@ -996,7 +2167,7 @@ type GameMessage_PingMsg struct {
 func (x *GameMessage_PingMsg) Reset() {
 	*x = GameMessage_PingMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[8]
+		mi := &file_p2p_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1009,7 +2180,7 @@ func (x *GameMessage_PingMsg) String() string {
 func (*GameMessage_PingMsg) ProtoMessage() {}
 
 func (x *GameMessage_PingMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[8]
+	mi := &file_p2p_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +2193,7 @@ func (x *GameMessage_PingMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMessage_PingMsg.ProtoReflect.Descriptor instead.
 func (*GameMessage_PingMsg) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5, 0}
+	return file_p2p_proto_rawDescGZIP(), []int{9, 0}
 }
 
 // Не-центральный игрок просит повернуть голову змеи
@@ -1037,7 +2208,7 @@ type GameMessage_SteerMsg struct {
 func (x *GameMessage_SteerMsg) Reset() {
 	*x = GameMessage_SteerMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[9]
+		mi := &file_p2p_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1050,7 +2221,7 @@ func (x *GameMessage_SteerMsg) String() string {
 func (*GameMessage_SteerMsg) ProtoMessage() {}
 
 func (x *GameMessage_SteerMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[9]
+	mi := &file_p2p_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1063,7 +2234,7 @@ func (x *GameMessage_SteerMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMessage_SteerMsg.ProtoReflect.Descriptor instead.
 func (*GameMessage_SteerMsg) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5, 1}
+	return file_p2p_proto_rawDescGZIP(), []int{9, 1}
 }
 
 func (x *GameMessage_SteerMsg) GetDirection() Direction {
@@ -1078,12 +2249,14 @@ type GameMessage_AckMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ReconnectToken *string `protobuf:"bytes,1,opt,name=reconnect_token,json=reconnectToken" json:"reconnect_token,omitempty"` // Токен для возвращения в игру, выдаётся в ответ на JoinMsg (расширение протокола)
 }
 
 func (x *GameMessage_AckMsg) Reset() {
 	*x = GameMessage_AckMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[10]
+		mi := &file_p2p_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1096,7 +2269,7 @@ func (x *GameMessage_AckMsg) String() string {
 func (*GameMessage_AckMsg) ProtoMessage() {}
 
 func (x *GameMessage_AckMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[10]
+	mi := &file_p2p_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,7 +2282,14 @@ func (x *GameMessage_AckMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMessage_AckMsg.ProtoReflect.Descriptor instead.
 func (*GameMessage_AckMsg) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5, 2}
+	return file_p2p_proto_rawDescGZIP(), []int{9, 2}
+}
+
+func (x *GameMessage_AckMsg) GetReconnectToken() string {
+	if x != nil && x.ReconnectToken != nil {
+		return *x.ReconnectToken
+	}
+	return ""
 }
 
 // Центральный узел сообщает остальным игрокам состояние игры
@@ -1118,13 +2298,13 @@ type GameMessage_StateMsg struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State *GameState `protobuf:"bytes,1,req,name=game" json:"game,omitempty"` // Состояние игрового поля
+	State *GameState `protobuf:"bytes,1,req,name=state" json:"state,omitempty"` // Состояние игрового поля
 }
 
 func (x *GameMessage_StateMsg) Reset() {
 	*x = GameMessage_StateMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[11]
+		mi := &file_p2p_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1137,7 +2317,7 @@ func (x *GameMessage_StateMsg) String() string {
 func (*GameMessage_StateMsg) ProtoMessage() {}
 
 func (x *GameMessage_StateMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[11]
+	mi := &file_p2p_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,7 +2330,7 @@ func (x *GameMessage_StateMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMessage_StateMsg.ProtoReflect.Descriptor instead.
 func (*GameMessage_StateMsg) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5, 3}
+	return file_p2p_proto_rawDescGZIP(), []int{9, 3}
 }
 
 func (x *GameMessage_StateMsg) GetState() *GameState {
@@ -1172,7 +2352,7 @@ type GameMessage_AnnouncementMsg struct {
 func (x *GameMessage_AnnouncementMsg) Reset() {
 	*x = GameMessage_AnnouncementMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[12]
+		mi := &file_p2p_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1185,7 +2365,7 @@ func (x *GameMessage_AnnouncementMsg) String() string {
 func (*GameMessage_AnnouncementMsg) ProtoMessage() {}
 
 func (x *GameMessage_AnnouncementMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[12]
+	mi := &file_p2p_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,7 +2378,7 @@ func (x *GameMessage_AnnouncementMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMessage_AnnouncementMsg.ProtoReflect.Descriptor instead.
 func (*GameMessage_AnnouncementMsg) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5, 4}
+	return file_p2p_proto_rawDescGZIP(), []int{9, 4}
 }
 
 func (x *GameMessage_AnnouncementMsg) GetGames() []*GameAnnouncement {
@@ -1218,7 +2398,7 @@ type GameMessage_DiscoverMsg struct {
 func (x *GameMessage_DiscoverMsg) Reset() {
 	*x = GameMessage_DiscoverMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[13]
+		mi := &file_p2p_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1231,7 +2411,7 @@ func (x *GameMessage_DiscoverMsg) String() string {
 func (*GameMessage_DiscoverMsg) ProtoMessage() {}
 
 func (x *GameMessage_DiscoverMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[13]
+	mi := &file_p2p_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1244,7 +2424,7 @@ func (x *GameMessage_DiscoverMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMessage_DiscoverMsg.ProtoReflect.Descriptor instead.
 func (*GameMessage_DiscoverMsg) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5, 5}
+	return file_p2p_proto_rawDescGZIP(), []int{9, 5}
 }
 
 // Новый игрок хочет присоединиться к идущей игре
@@ -1253,21 +2433,24 @@ type GameMessage_JoinMsg struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerType    *PlayerType `protobuf:"varint,1,opt,name=player_type,json=playerType,enum=p2p.PlayerType,def=0" json:"player_type,omitempty"`  // Тип присоединяющегося игрока
-	PlayerName    *string     `protobuf:"bytes,3,req,name=player_name,json=playerName" json:"player_name,omitempty"`                             // Имя игрока
-	GameName      *string     `protobuf:"bytes,4,req,name=game_name,json=gameName" json:"game_name,omitempty"`                                   // Глобально уникальное имя игры, к которой хотим присоединиться
-	RequestedRole *NodeRole   `protobuf:"varint,5,req,name=requested_role,json=requestedRole,enum=p2p.NodeRole" json:"requested_role,omitempty"` // NORMAL, если хотим играть; VIEWER, если хотим только понаблюдать; остальные значения недопустимы
+	PlayerType     *PlayerType `protobuf:"varint,1,opt,name=player_type,json=playerType,enum=p2p.PlayerType,def=0" json:"player_type,omitempty"`  // Тип присоединяющегося игрока
+	PlayerName     *string     `protobuf:"bytes,3,req,name=player_name,json=playerName" json:"player_name,omitempty"`                             // Имя игрока
+	GameName       *string     `protobuf:"bytes,4,req,name=game_name,json=gameName" json:"game_name,omitempty"`                                   // Глобально уникальное имя игры, к которой хотим присоединиться
+	RequestedRole  *NodeRole   `protobuf:"varint,5,req,name=requested_role,json=requestedRole,enum=p2p.NodeRole" json:"requested_role,omitempty"` // NORMAL, если хотим играть; VIEWER, если хотим только понаблюдать; остальные значения недопустимы
+	ReconnectToken *string     `protobuf:"bytes,6,opt,name=reconnect_token,json=reconnectToken" json:"reconnect_token,omitempty"`                 // Токен, полученный при прошлом присоединении к этой игре (расширение протокола)
+	Team           *int32      `protobuf:"varint,7,opt,name=team,def=0" json:"team,omitempty"`                                                    // Команда, в которую хотим вступить, 0 - назначается автоматически (расширение протокола)
 }
 
 // Default values for GameMessage_JoinMsg fields.
 const (
 	Default_GameMessage_JoinMsg_PlayerType = PlayerType_HUMAN
+	Default_GameMessage_JoinMsg_Team       = int32(0)
 )
 
 func (x *GameMessage_JoinMsg) Reset() {
 	*x = GameMessage_JoinMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[14]
+		mi := &file_p2p_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1280,7 +2463,7 @@ func (x *GameMessage_JoinMsg) String() string {
 func (*GameMessage_JoinMsg) ProtoMessage() {}
 
 func (x *GameMessage_JoinMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[14]
+	mi := &file_p2p_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,7 +2476,7 @@ func (x *GameMessage_JoinMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMessage_JoinMsg.ProtoReflect.Descriptor instead.
 func (*GameMessage_JoinMsg) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5, 6}
+	return file_p2p_proto_rawDescGZIP(), []int{9, 6}
 }
 
 func (x *GameMessage_JoinMsg) GetPlayerType() PlayerType {
@@ -1324,6 +2507,20 @@ func (x *GameMessage_JoinMsg) GetRequestedRole() NodeRole {
 	return NodeRole_NORMAL
 }
 
+func (x *GameMessage_JoinMsg) GetReconnectToken() string {
+	if x != nil && x.ReconnectToken != nil {
+		return *x.ReconnectToken
+	}
+	return ""
+}
+
+func (x *GameMessage_JoinMsg) GetTeam() int32 {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return Default_GameMessage_JoinMsg_Team
+}
+
 // Ошибка операции (например отказ в присоединении к игре, т.к. нет места на поле)
 type GameMessage_ErrorMsg struct {
 	state         protoimpl.MessageState
@@ -1336,7 +2533,7 @@ type GameMessage_ErrorMsg struct {
 func (x *GameMessage_ErrorMsg) Reset() {
 	*x = GameMessage_ErrorMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[15]
+		mi := &file_p2p_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1349,7 +2546,7 @@ func (x *GameMessage_ErrorMsg) String() string {
 func (*GameMessage_ErrorMsg) ProtoMessage() {}
 
 func (x *GameMessage_ErrorMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[15]
+	mi := &file_p2p_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,7 +2559,7 @@ func (x *GameMessage_ErrorMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMessage_ErrorMsg.ProtoReflect.Descriptor instead.
 func (*GameMessage_ErrorMsg) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5, 7}
+	return file_p2p_proto_rawDescGZIP(), []int{9, 7}
 }
 
 func (x *GameMessage_ErrorMsg) GetErrorMessage() string {
@@ -1390,7 +2587,7 @@ type GameMessage_RoleChangeMsg struct {
 func (x *GameMessage_RoleChangeMsg) Reset() {
 	*x = GameMessage_RoleChangeMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[16]
+		mi := &file_p2p_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1403,7 +2600,7 @@ func (x *GameMessage_RoleChangeMsg) String() string {
 func (*GameMessage_RoleChangeMsg) ProtoMessage() {}
 
 func (x *GameMessage_RoleChangeMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[16]
+	mi := &file_p2p_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +2613,7 @@ func (x *GameMessage_RoleChangeMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMessage_RoleChangeMsg.ProtoReflect.Descriptor instead.
 func (*GameMessage_RoleChangeMsg) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5, 8}
+	return file_p2p_proto_rawDescGZIP(), []int{9, 8}
 }
 
 func (x *GameMessage_RoleChangeMsg) GetSenderRole() NodeRole {
@@ -1437,7 +2634,7 @@ var File_p2p_proto protoreflect.FileDescriptor
 
 var file_p2p_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x70, 0x32, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x70, 0x32, 0x70,
-	0x22, 0xc8, 0x01, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x22, 0x83, 0x03, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52,
 	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
@@ -1449,143 +2646,342 @@ var file_p2p_proto_rawDesc = []byte{
 	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x50, 0x6c,
 	0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x05, 0x48, 0x55, 0x4d, 0x41, 0x4e, 0x52,
 	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07,
-	0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0a,
-	0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x05, 0x77, 0x69,
-	0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x34, 0x30, 0x52, 0x05, 0x77,
-	0x69, 0x64, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x33, 0x30, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x22, 0x0a, 0x0b, 0x66, 0x6f, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x31, 0x52, 0x0a, 0x66, 0x6f, 0x6f, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x63, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x04, 0x31, 0x30,
-	0x30, 0x30, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73,
-	0x22, 0x38, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
-	0x29, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0xcc, 0x03, 0x0a, 0x09, 0x47,
-	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0a, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x6e, 0x61,
-	0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x32, 0x70, 0x2e,
-	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x52,
-	0x06, 0x73, 0x6e, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x66, 0x6f, 0x6f, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x66, 0x6f,
-	0x6f, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04,
-	0x20, 0x02, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x1a,
-	0x29, 0x0a, 0x05, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x12, 0x0f, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x11, 0x3a, 0x01, 0x30, 0x52, 0x01, 0x78, 0x12, 0x0f, 0x0a, 0x01, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x11, 0x3a, 0x01, 0x30, 0x52, 0x01, 0x79, 0x1a, 0xec, 0x01, 0x0a, 0x05, 0x53,
-	0x6e, 0x61, 0x6b, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a,
+	0x09, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x15, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30,
+	0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x22, 0x4e, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xdd, 0x02, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0a, 0x66, 0x6f, 0x6f, 0x64, 0x5f, 0x65,
+	0x61, 0x74, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x09, 0x66,
+	0x6f, 0x6f, 0x64, 0x45, 0x61, 0x74, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c,
+	0x73, 0x12, 0x19, 0x0a, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x3a, 0x01, 0x30, 0x52, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x12, 0x3e, 0x0a, 0x0c,
+	0x64, 0x65, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x0b, 0x64, 0x65, 0x61, 0x74, 0x68, 0x43, 0x61, 0x75, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x3a, 0x01, 0x30, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x28,
+	0x0a, 0x0e, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x73,
+	0x53, 0x75, 0x72, 0x76, 0x69, 0x76, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x05, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x05, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x1a, 0x53, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2f, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44,
+	0x65, 0x61, 0x74, 0x68, 0x43, 0x61, 0x75, 0x73, 0x65, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x85, 0x07, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x34, 0x30, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x1a, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x3a,
+	0x02, 0x33, 0x30, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0b, 0x66,
+	0x6f, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x3a, 0x01, 0x31, 0x52, 0x0a, 0x66, 0x6f, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12,
+	0x2a, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x04, 0x31, 0x30, 0x30, 0x30, 0x52, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x06, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c,
+	0x73, 0x65, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x77, 0x61,
+	0x6c, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x32, 0x70, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52,
+	0x05, 0x77, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6f,
+	0x72, 0x64, 0x52, 0x0b, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x33, 0x0a, 0x0a, 0x66, 0x6f, 0x6f, 0x64, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52, 0x09, 0x66, 0x6f, 0x6f, 0x64, 0x53,
+	0x70, 0x6f, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x54,
+	0x75, 0x72, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x13, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x6e, 0x61, 0x6b, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x1e, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x2f, 0x0a, 0x12, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x6f, 0x64,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x31,
+	0x52, 0x10, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x46, 0x6f, 0x6f, 0x64, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x2f, 0x0a, 0x12, 0x67, 0x6f, 0x6c, 0x64, 0x65, 0x6e, 0x5f, 0x66, 0x6f, 0x6f,
+	0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01,
+	0x30, 0x52, 0x10, 0x67, 0x6f, 0x6c, 0x64, 0x65, 0x6e, 0x46, 0x6f, 0x6f, 0x64, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2f, 0x0a, 0x12, 0x70, 0x6f, 0x69, 0x73, 0x6f, 0x6e, 0x5f, 0x66, 0x6f,
+	0x6f, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x3a,
+	0x01, 0x30, 0x52, 0x10, 0x70, 0x6f, 0x69, 0x73, 0x6f, 0x6e, 0x46, 0x6f, 0x6f, 0x64, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x3a, 0x0a, 0x16,
+	0x68, 0x61, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61,
+	0x6c, 0x73, 0x65, 0x52, 0x13, 0x68, 0x61, 0x6c, 0x76, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x2a, 0x0a, 0x0f, 0x73, 0x68, 0x72, 0x69,
+	0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x05, 0x3a, 0x01, 0x30, 0x52, 0x0e, 0x73, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x09, 0x74, 0x65, 0x61,
+	0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x0e, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x74,
+	0x65, 0x61, 0x6d, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05,
+	0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0d, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x6d,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x34, 0x52,
+	0x0d, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x38,
+	0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0xab, 0x0b, 0x0a, 0x09, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x6e, 0x61, 0x6b, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x52, 0x06, 0x73,
+	0x6e, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x66, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x66, 0x6f, 0x6f, 0x64,
+	0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x02,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x31,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x36, 0x0a, 0x0a, 0x66, 0x6f, 0x6f, 0x64, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x46, 0x6f, 0x6f, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x09,
+	0x66, 0x6f, 0x6f, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0b, 0x61, 0x72, 0x65,
+	0x6e, 0x61, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01,
+	0x30, 0x52, 0x0a, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2d, 0x0a,
+	0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x32,
+	0x70, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x1a, 0x29, 0x0a,
+	0x05, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x12, 0x0f, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x11, 0x3a, 0x01, 0x30, 0x52, 0x01, 0x78, 0x12, 0x0f, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x11, 0x3a, 0x01, 0x30, 0x52, 0x01, 0x79, 0x1a, 0x9f, 0x04, 0x0a, 0x05, 0x53, 0x6e, 0x61,
+	0x6b, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70,
+	0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x6e, 0x61,
+	0x6b, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x05, 0x41,
+	0x4c, 0x49, 0x56, 0x45, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x0e, 0x68,
+	0x65, 0x61, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x02, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10,
+	0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x77,
+	0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x06, 0x67, 0x72, 0x6f,
+	0x77, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x61,
+	0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x65,
+	0x61, 0x6d, 0x12, 0x31, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x23, 0x0a, 0x0a, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x5a, 0x4f, 0x4d, 0x42, 0x49, 0x45, 0x10, 0x01, 0x1a, 0xa6, 0x01, 0x0a, 0x04, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x2a, 0x0a, 0x05, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x22, 0x44, 0x0a,
+	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x50, 0x45, 0x45, 0x44, 0x5f, 0x42,
+	0x4f, 0x4f, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x52, 0x49, 0x4e, 0x4b,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x49, 0x45,
+	0x52, 0x10, 0x03, 0x1a, 0x52, 0x0a, 0x06, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x2c, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x32,
+	0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x3d, 0x0a, 0x08, 0x46, 0x6f, 0x6f, 0x64, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x46, 0x4f,
+	0x4f, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x4f, 0x4c, 0x44, 0x45, 0x4e, 0x5f, 0x46,
+	0x4f, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4f, 0x49, 0x53, 0x4f, 0x4e, 0x5f,
+	0x46, 0x4f, 0x4f, 0x44, 0x10, 0x02, 0x22, 0xa0, 0x01, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x55, 0x52, 0x4e,
+	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x43, 0x4f, 0x52,
+	0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x53,
+	0x54, 0x5f, 0x53, 0x4e, 0x41, 0x4b, 0x45, 0x10, 0x02, 0x22, 0x88, 0x04, 0x0a, 0x09, 0x47, 0x61,
+	0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
-	0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x1f,
-	0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53,
-	0x6e, 0x61, 0x6b, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x3a,
-	0x05, 0x41, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a,
-	0x0e, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x23, 0x0a, 0x0a, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x5a, 0x4f, 0x4d, 0x42, 0x49, 0x45, 0x10, 0x01, 0x22, 0xa5, 0x01, 0x0a, 0x10, 0x47, 0x61,
-	0x6d, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a,
-	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x32, 0x70,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x07, 0x63, 0x61, 0x6e,
-	0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x88, 0x09, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x02,
-	0x28, 0x03, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67,
-	0x48, 0x00, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x65, 0x65, 0x72, 0x4d,
-	0x73, 0x67, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x65, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x03, 0x61,
-	0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x63, 0x6b, 0x4d, 0x73,
-	0x67, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d,
-	0x73, 0x67, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x61,
-	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x04, 0x6a,
-	0x6f, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x32,
-	0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x6f,
-	0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x0a, 0x72,
-	0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x64, 0x69, 0x73,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x32,
-	0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x73,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x1a, 0x09, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67,
-	0x1a, 0x38, 0x0a, 0x08, 0x53, 0x74, 0x65, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x09,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32,
-	0x0e, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x0a, 0x06, 0x41, 0x63,
-	0x6b, 0x4d, 0x73, 0x67, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67,
-	0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x3e, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x61, 0x6d,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x0d, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0xb6, 0x01, 0x0a, 0x07, 0x4a, 0x6f, 0x69, 0x6e, 0x4d, 0x73,
-	0x67, 0x12, 0x37, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x05, 0x48, 0x55, 0x4d, 0x41, 0x4e, 0x52, 0x0a,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52,
-	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67,
-	0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08,
-	0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x02, 0x28, 0x0e,
-	0x32, 0x0d, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x1a, 0x2f,
-	0x0a, 0x08, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28,
-	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
-	0x73, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67,
-	0x12, 0x2e, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
-	0x12, 0x32, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
-	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x2a, 0x3a, 0x0a, 0x08,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d,
-	0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01,
-	0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x50, 0x55, 0x54, 0x59, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
-	0x56, 0x49, 0x45, 0x57, 0x45, 0x52, 0x10, 0x03, 0x2a, 0x22, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x55, 0x4d, 0x41, 0x4e, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x4f, 0x42, 0x4f, 0x54, 0x10, 0x01, 0x2a, 0x32, 0x0a, 0x09,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10,
-	0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4c,
-	0x45, 0x46, 0x54, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x04,
-	0x42, 0x10, 0x5a, 0x0e, 0x2e, 0x2f, 0x70, 0x32, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c,
+	0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x12, 0x2f,
+	0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65,
+	0x61, 0x74, 0x68, 0x43, 0x61, 0x75, 0x73, 0x65, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x09, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x22, 0xb3, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x4f, 0x44, 0x5f, 0x45, 0x41, 0x54, 0x45, 0x4e,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x4e, 0x41, 0x4b, 0x45, 0x5f, 0x44, 0x49, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x5f, 0x41, 0x57, 0x41,
+	0x52, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x4f, 0x44, 0x5f, 0x53,
+	0x50, 0x41, 0x57, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x5a, 0x4f, 0x4d, 0x42,
+	0x49, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d,
+	0x47, 0x41, 0x4d, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x10, 0x0a, 0x0c, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x50, 0x41, 0x57, 0x4e, 0x45, 0x44, 0x10,
+	0x06, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x50, 0x49, 0x43, 0x4b, 0x45, 0x44,
+	0x5f, 0x55, 0x50, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x4e, 0x41, 0x4b, 0x45, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x41, 0x57, 0x4e, 0x45, 0x44, 0x10, 0x08, 0x22, 0x38, 0x0a, 0x0a, 0x44, 0x65,
+	0x61, 0x74, 0x68, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4c, 0x46,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x4e, 0x41, 0x4b, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x48, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41,
+	0x4c, 0x4c, 0x10, 0x03, 0x22, 0xc6, 0x01, 0x0a, 0x10, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x32, 0x70,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f,
+	0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x32,
+	0x70, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0xf1, 0x09,
+	0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x6d, 0x73, 0x67, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x02, 0x28, 0x03, 0x52, 0x06,
+	0x6d, 0x73, 0x67, 0x53, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x04,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x65, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x48, 0x00,
+	0x52, 0x05, 0x73, 0x74, 0x65, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52,
+	0x03, 0x61, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x48, 0x00,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x48,
+	0x00, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12,
+	0x31, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x41, 0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x1a, 0x09, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x38, 0x0a, 0x08,
+	0x53, 0x74, 0x65, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x32,
+	0x70, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x31, 0x0a, 0x06, 0x41, 0x63, 0x6b, 0x4d, 0x73, 0x67,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x3e, 0x0a, 0x0f, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x2b,
+	0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x32, 0x70, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x0d, 0x0a, 0x0b, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0xf6, 0x01, 0x0a, 0x07, 0x4a,
+	0x6f, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x37, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x32,
+	0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x05, 0x48, 0x55,
+	0x4d, 0x41, 0x4e, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x32, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x0a, 0x04,
+	0x74, 0x65, 0x61, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x04, 0x74,
+	0x65, 0x61, 0x6d, 0x1a, 0x2f, 0x0a, 0x08, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x73, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x32, 0x70,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70,
+	0x32, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x2a, 0x3a, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a,
+	0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x53,
+	0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x50, 0x55, 0x54, 0x59, 0x10,
+	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x49, 0x45, 0x57, 0x45, 0x52, 0x10, 0x03, 0x2a, 0x22, 0x0a,
+	0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x48,
+	0x55, 0x4d, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x4f, 0x42, 0x4f, 0x54, 0x10,
+	0x01, 0x2a, 0x32, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x06,
+	0x0a, 0x02, 0x55, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02,
+	0x12, 0x08, 0x0a, 0x04, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x49,
+	0x47, 0x48, 0x54, 0x10, 0x04, 0x42, 0x10, 0x5a, 0x0e, 0x2e, 0x2f, 0x70, 0x32, 0x70, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
 }
 
 var (
@@ -1600,64 +2996,98 @@ func file_p2p_proto_rawDescGZIP() []byte {
 	return file_p2p_proto_rawDescData
 }
 
-var file_p2p_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_p2p_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_p2p_proto_goTypes = []interface{}{
 	(NodeRole)(0),                       // 0: p2p.NodeRole
 	(PlayerType)(0),                     // 1: p2p.PlayerType
 	(Direction)(0),                      // 2: p2p.Direction
-	(GameState_Snake_SnakeState)(0),     // 3: p2p.GameState.Snake.SnakeState
-	(*GamePlayer)(nil),                  // 4: p2p.GamePlayer
-	(*GameConfig)(nil),                  // 5: p2p.GameConfig
-	(*GamePlayers)(nil),                 // 6: p2p.GamePlayers
-	(*GameState)(nil),                   // 7: p2p.GameState
-	(*GameAnnouncement)(nil),            // 8: p2p.GameAnnouncement
-	(*GameMessage)(nil),                 // 9: p2p.GameMessage
-	(*GameState_Coord)(nil),             // 10: p2p.GameState.Coord
-	(*GameState_Snake)(nil),             // 11: p2p.GameState.Snake
-	(*GameMessage_PingMsg)(nil),         // 12: p2p.GameMessage.PingMsg
-	(*GameMessage_SteerMsg)(nil),        // 13: p2p.GameMessage.SteerMsg
-	(*GameMessage_AckMsg)(nil),          // 14: p2p.GameMessage.AckMsg
-	(*GameMessage_StateMsg)(nil),        // 15: p2p.GameMessage.StateMsg
-	(*GameMessage_AnnouncementMsg)(nil), // 16: p2p.GameMessage.AnnouncementMsg
-	(*GameMessage_DiscoverMsg)(nil),     // 17: p2p.GameMessage.DiscoverMsg
-	(*GameMessage_JoinMsg)(nil),         // 18: p2p.GameMessage.JoinMsg
-	(*GameMessage_ErrorMsg)(nil),        // 19: p2p.GameMessage.ErrorMsg
-	(*GameMessage_RoleChangeMsg)(nil),   // 20: p2p.GameMessage.RoleChangeMsg
+	(GameState_FoodKind)(0),             // 3: p2p.GameState.FoodKind
+	(GameState_Snake_SnakeState)(0),     // 4: p2p.GameState.Snake.SnakeState
+	(GameState_Item_Kind)(0),            // 5: p2p.GameState.Item.Kind
+	(GameResult_FinishReason)(0),        // 6: p2p.GameResult.FinishReason
+	(GameEvent_EventType)(0),            // 7: p2p.GameEvent.EventType
+	(GameEvent_DeathCause)(0),           // 8: p2p.GameEvent.DeathCause
+	(*GamePlayer)(nil),                  // 9: p2p.GamePlayer
+	(*Team)(nil),                        // 10: p2p.Team
+	(*PlayerStats)(nil),                 // 11: p2p.PlayerStats
+	(*GameConfig)(nil),                  // 12: p2p.GameConfig
+	(*GamePlayers)(nil),                 // 13: p2p.GamePlayers
+	(*GameState)(nil),                   // 14: p2p.GameState
+	(*GameResult)(nil),                  // 15: p2p.GameResult
+	(*GameEvent)(nil),                   // 16: p2p.GameEvent
+	(*GameAnnouncement)(nil),            // 17: p2p.GameAnnouncement
+	(*GameMessage)(nil),                 // 18: p2p.GameMessage
+	(*PlayerStats_DeathCount)(nil),      // 19: p2p.PlayerStats.DeathCount
+	(*GameState_Coord)(nil),             // 20: p2p.GameState.Coord
+	(*GameState_Snake)(nil),             // 21: p2p.GameState.Snake
+	(*GameState_Item)(nil),              // 22: p2p.GameState.Item
+	(*GameState_Effect)(nil),            // 23: p2p.GameState.Effect
+	(*GameMessage_PingMsg)(nil),         // 24: p2p.GameMessage.PingMsg
+	(*GameMessage_SteerMsg)(nil),        // 25: p2p.GameMessage.SteerMsg
+	(*GameMessage_AckMsg)(nil),          // 26: p2p.GameMessage.AckMsg
+	(*GameMessage_StateMsg)(nil),        // 27: p2p.GameMessage.StateMsg
+	(*GameMessage_AnnouncementMsg)(nil), // 28: p2p.GameMessage.AnnouncementMsg
+	(*GameMessage_DiscoverMsg)(nil),     // 29: p2p.GameMessage.DiscoverMsg
+	(*GameMessage_JoinMsg)(nil),         // 30: p2p.GameMessage.JoinMsg
+	(*GameMessage_ErrorMsg)(nil),        // 31: p2p.GameMessage.ErrorMsg
+	(*GameMessage_RoleChangeMsg)(nil),   // 32: p2p.GameMessage.RoleChangeMsg
 }
 var file_p2p_proto_depIdxs = []int32{
 	0,  // 0: p2p.GamePlayer.role:type_name -> p2p.NodeRole
 	1,  // 1: p2p.GamePlayer.type:type_name -> p2p.PlayerType
-	4,  // 2: p2p.GamePlayers.players:type_name -> p2p.GamePlayer
-	11, // 3: p2p.GameState.snakes:type_name -> p2p.GameState.Snake
-	10, // 4: p2p.GameState.foods:type_name -> p2p.GameState.Coord
-	6,  // 5: p2p.GameState.players:type_name -> p2p.GamePlayers
-	6,  // 6: p2p.GameAnnouncement.players:type_name -> p2p.GamePlayers
-	5,  // 7: p2p.GameAnnouncement.config:type_name -> p2p.GameConfig
-	12, // 8: p2p.GameMessage.ping:type_name -> p2p.GameMessage.PingMsg
-	13, // 9: p2p.GameMessage.steer:type_name -> p2p.GameMessage.SteerMsg
-	14, // 10: p2p.GameMessage.ack:type_name -> p2p.GameMessage.AckMsg
-	15, // 11: p2p.GameMessage.game:type_name -> p2p.GameMessage.StateMsg
-	16, // 12: p2p.GameMessage.announcement:type_name -> p2p.GameMessage.AnnouncementMsg
-	18, // 13: p2p.GameMessage.join:type_name -> p2p.GameMessage.JoinMsg
-	19, // 14: p2p.GameMessage.error:type_name -> p2p.GameMessage.ErrorMsg
-	20, // 15: p2p.GameMessage.role_change:type_name -> p2p.GameMessage.RoleChangeMsg
-	17, // 16: p2p.GameMessage.discover:type_name -> p2p.GameMessage.DiscoverMsg
-	10, // 17: p2p.GameState.Snake.points:type_name -> p2p.GameState.Coord
-	3,  // 18: p2p.GameState.Snake.game:type_name -> p2p.GameState.Snake.SnakeState
-	2,  // 19: p2p.GameState.Snake.head_direction:type_name -> p2p.Direction
-	2,  // 20: p2p.GameMessage.SteerMsg.direction:type_name -> p2p.Direction
-	7,  // 21: p2p.GameMessage.StateMsg.game:type_name -> p2p.GameState
-	8,  // 22: p2p.GameMessage.AnnouncementMsg.games:type_name -> p2p.GameAnnouncement
-	1,  // 23: p2p.GameMessage.JoinMsg.player_type:type_name -> p2p.PlayerType
-	0,  // 24: p2p.GameMessage.JoinMsg.requested_role:type_name -> p2p.NodeRole
-	0,  // 25: p2p.GameMessage.RoleChangeMsg.sender_role:type_name -> p2p.NodeRole
-	0,  // 26: p2p.GameMessage.RoleChangeMsg.receiver_role:type_name -> p2p.NodeRole
-	27, // [27:27] is the sub-list for method output_type
-	27, // [27:27] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	11, // 2: p2p.GamePlayer.stats:type_name -> p2p.PlayerStats
+	19, // 3: p2p.PlayerStats.death_causes:type_name -> p2p.PlayerStats.DeathCount
+	20, // 4: p2p.GameConfig.walls:type_name -> p2p.GameState.Coord
+	20, // 5: p2p.GameConfig.spawn_points:type_name -> p2p.GameState.Coord
+	20, // 6: p2p.GameConfig.food_spots:type_name -> p2p.GameState.Coord
+	9,  // 7: p2p.GamePlayers.players:type_name -> p2p.GamePlayer
+	21, // 8: p2p.GameState.snakes:type_name -> p2p.GameState.Snake
+	20, // 9: p2p.GameState.foods:type_name -> p2p.GameState.Coord
+	13, // 10: p2p.GameState.players:type_name -> p2p.GamePlayers
+	16, // 11: p2p.GameState.events:type_name -> p2p.GameEvent
+	15, // 12: p2p.GameState.result:type_name -> p2p.GameResult
+	22, // 13: p2p.GameState.items:type_name -> p2p.GameState.Item
+	3,  // 14: p2p.GameState.food_kinds:type_name -> p2p.GameState.FoodKind
+	10, // 15: p2p.GameState.teams:type_name -> p2p.Team
+	6,  // 16: p2p.GameResult.reason:type_name -> p2p.GameResult.FinishReason
+	7,  // 17: p2p.GameEvent.type:type_name -> p2p.GameEvent.EventType
+	20, // 18: p2p.GameEvent.coord:type_name -> p2p.GameState.Coord
+	8,  // 19: p2p.GameEvent.cause:type_name -> p2p.GameEvent.DeathCause
+	5,  // 20: p2p.GameEvent.item:type_name -> p2p.GameState.Item.Kind
+	13, // 21: p2p.GameAnnouncement.players:type_name -> p2p.GamePlayers
+	12, // 22: p2p.GameAnnouncement.config:type_name -> p2p.GameConfig
+	10, // 23: p2p.GameAnnouncement.teams:type_name -> p2p.Team
+	24, // 24: p2p.GameMessage.ping:type_name -> p2p.GameMessage.PingMsg
+	25, // 25: p2p.GameMessage.steer:type_name -> p2p.GameMessage.SteerMsg
+	26, // 26: p2p.GameMessage.ack:type_name -> p2p.GameMessage.AckMsg
+	27, // 27: p2p.GameMessage.state:type_name -> p2p.GameMessage.StateMsg
+	28, // 28: p2p.GameMessage.announcement:type_name -> p2p.GameMessage.AnnouncementMsg
+	30, // 29: p2p.GameMessage.join:type_name -> p2p.GameMessage.JoinMsg
+	31, // 30: p2p.GameMessage.error:type_name -> p2p.GameMessage.ErrorMsg
+	32, // 31: p2p.GameMessage.role_change:type_name -> p2p.GameMessage.RoleChangeMsg
+	29, // 32: p2p.GameMessage.discover:type_name -> p2p.GameMessage.DiscoverMsg
+	8,  // 33: p2p.PlayerStats.DeathCount.cause:type_name -> p2p.GameEvent.DeathCause
+	20, // 34: p2p.GameState.Snake.points:type_name -> p2p.GameState.Coord
+	4,  // 35: p2p.GameState.Snake.state:type_name -> p2p.GameState.Snake.SnakeState
+	2,  // 36: p2p.GameState.Snake.head_direction:type_name -> p2p.Direction
+	23, // 37: p2p.GameState.Snake.effects:type_name -> p2p.GameState.Effect
+	11, // 38: p2p.GameState.Snake.owner_stats:type_name -> p2p.PlayerStats
+	5,  // 39: p2p.GameState.Item.kind:type_name -> p2p.GameState.Item.Kind
+	20, // 40: p2p.GameState.Item.coord:type_name -> p2p.GameState.Coord
+	5,  // 41: p2p.GameState.Effect.kind:type_name -> p2p.GameState.Item.Kind
+	2,  // 42: p2p.GameMessage.SteerMsg.direction:type_name -> p2p.Direction
+	14, // 43: p2p.GameMessage.StateMsg.state:type_name -> p2p.GameState
+	17, // 44: p2p.GameMessage.AnnouncementMsg.games:type_name -> p2p.GameAnnouncement
+	1,  // 45: p2p.GameMessage.JoinMsg.player_type:type_name -> p2p.PlayerType
+	0,  // 46: p2p.GameMessage.JoinMsg.requested_role:type_name -> p2p.NodeRole
+	0,  // 47: p2p.GameMessage.RoleChangeMsg.sender_role:type_name -> p2p.NodeRole
+	0,  // 48: p2p.GameMessage.RoleChangeMsg.receiver_role:type_name -> p2p.NodeRole
+	49, // [49:49] is the sub-list for method output_type
+	49, // [49:49] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_p2p_proto_init() }
@@ -1679,7 +3109,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameConfig); i {
+			switch v := v.(*Team); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1691,7 +3121,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GamePlayers); i {
+			switch v := v.(*PlayerStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1703,7 +3133,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameState); i {
+			switch v := v.(*GameConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1715,7 +3145,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameAnnouncement); i {
+			switch v := v.(*GamePlayers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1727,7 +3157,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameMessage); i {
+			switch v := v.(*GameState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1739,7 +3169,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameState_Coord); i {
+			switch v := v.(*GameResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1751,7 +3181,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameState_Snake); i {
+			switch v := v.(*GameEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1763,7 +3193,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameMessage_PingMsg); i {
+			switch v := v.(*GameAnnouncement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1775,7 +3205,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameMessage_SteerMsg); i {
+			switch v := v.(*GameMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1787,7 +3217,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameMessage_AckMsg); i {
+			switch v := v.(*PlayerStats_DeathCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1799,7 +3229,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameMessage_StateMsg); i {
+			switch v := v.(*GameState_Coord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1811,7 +3241,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameMessage_AnnouncementMsg); i {
+			switch v := v.(*GameState_Snake); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1823,7 +3253,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameMessage_DiscoverMsg); i {
+			switch v := v.(*GameState_Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1835,7 +3265,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameMessage_JoinMsg); i {
+			switch v := v.(*GameState_Effect); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1847,7 +3277,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameMessage_ErrorMsg); i {
+			switch v := v.(*GameMessage_PingMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1859,6 +3289,90 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameMessage_SteerMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2p_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameMessage_AckMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2p_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameMessage_StateMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2p_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameMessage_AnnouncementMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2p_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameMessage_DiscoverMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2p_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameMessage_JoinMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2p_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameMessage_ErrorMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2p_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameMessage_RoleChangeMsg); i {
 			case 0:
 				return &v.state
@@ -1871,7 +3385,7 @@ func file_p2p_proto_init() {
 			}
 		}
 	}
-	file_p2p_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_p2p_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*GameMessage_Ping)(nil),
 		(*GameMessage_Steer)(nil),
 		(*GameMessage_Ack)(nil),
@@ -1887,8 +3401,8 @@ func file_p2p_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_p2p_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   17,
+			NumEnums:      9,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,154 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"p2p-snake/internal/log"
+	"p2p-snake/internal/p2p/game"
+	"p2p-snake/internal/p2p/protocol"
+)
+
+var illegalRoleTransitionError = fmt.Errorf("illegal role transition")
+
+// Causes of the role changes
+type roleTrigger int32
+
+const (
+	masterExpiredTrigger roleTrigger = iota + 1 // DEPUTY has not heard from MASTER for 0.8 * state_delay
+	takeOverTrigger                             // DEPUTY reports that it has become MASTER (sender_role = MASTER)
+	leaveTrigger                                // Player leaves the game (sender_role = VIEWER)
+	deathTrigger                                // Snake of the player has died (receiver_role = VIEWER)
+	appointDeputyTrigger                        // MASTER appoints the player as DEPUTY (receiver_role = DEPUTY)
+	handOverTrigger                             // Leaving MASTER hands the game over to DEPUTY (receiver_role = MASTER)
+	respawnTrigger                              // Snake of the player has respawned (receiver_role = NORMAL)
+)
+
+// Allowed role transitions, the same for the current node and for the remote ones
+var roleTransitions = map[protocol.NodeRole]map[roleTrigger]protocol.NodeRole{
+	protocol.NodeRole_MASTER: {
+		leaveTrigger: protocol.NodeRole_VIEWER,
+	},
+	protocol.NodeRole_DEPUTY: {
+		masterExpiredTrigger: protocol.NodeRole_MASTER,
+		takeOverTrigger:      protocol.NodeRole_MASTER,
+		handOverTrigger:      protocol.NodeRole_MASTER,
+		leaveTrigger:         protocol.NodeRole_VIEWER,
+		deathTrigger:         protocol.NodeRole_VIEWER,
+	},
+	protocol.NodeRole_NORMAL: {
+		appointDeputyTrigger: protocol.NodeRole_DEPUTY,
+		leaveTrigger:         protocol.NodeRole_VIEWER,
+		deathTrigger:         protocol.NodeRole_VIEWER,
+	},
+	protocol.NodeRole_VIEWER: {
+		respawnTrigger: protocol.NodeRole_NORMAL,
+	},
+}
+
+// Triggers of the role changes requested in RoleChangeMsg with receiver_role
+var receiverRoleTriggers = map[protocol.NodeRole]roleTrigger{
+	protocol.NodeRole_MASTER: handOverTrigger,
+	protocol.NodeRole_DEPUTY: appointDeputyTrigger,
+	protocol.NodeRole_NORMAL: respawnTrigger,
+	protocol.NodeRole_VIEWER: deathTrigger,
+}
+
+func nextRole(role protocol.NodeRole, trigger roleTrigger) (protocol.NodeRole, error) {
+	if next, ok := roleTransitions[role][trigger]; ok {
+		return next, nil
+	}
+	return role, illegalRoleTransitionError
+}
+
+// Changes the role of the node by the trigger. The current node runs the goroutines of its new role, the messages
+// to MASTER follow the new MASTER
+func (p *Peer) transitRole(node *game.NodeInfo, trigger roleTrigger) error {
+	role, err := nextRole(node.Role(), trigger)
+	if err != nil {
+		log.Logger.Debugf("P2P node error: %v (%v of player %d on trigger %d)", err, node.Role(), node.PlayerId(),
+			trigger)
+		return err
+	}
+
+	wasMaster := node.IsMasterNode()
+	node.SetRole(role)
+	p.followMaster()
+	if node.PlayerId() == p.gameInfo.CurrentNode().PlayerId() && wasMaster != node.IsMasterNode() {
+		p.runGame()
+	}
+	return nil
+}
+
+// Restarts the goroutines of the game: MASTER runs the game, other nodes watch MASTER
+func (p *Peer) runGame() {
+	p.cancelGame()
+
+	var ctx context.Context
+	ctx, p.cancelGame = context.WithCancel(context.Background())
+	if p.gameInfo.CurrentNode().IsMasterNode() {
+		// Start sending messages with announcement and game state, receiving messages from players and
+		// deleting “dead” nodes
+		p.wg.Add(4)
+		go p.announceGame(ctx)
+		go p.publishState(ctx)
+		go p.pingNode(ctx)
+		go p.deleteExpiredNode(ctx)
+	} else {
+		p.wg.Add(2)
+		go p.pingMaster(ctx)
+		go p.deleteExpiredMaster(ctx)
+	}
+}
+
+// Makes the DEPUTY that has reported about taking over the game the MASTER, the old MASTER becomes a VIEWER
+func (p *Peer) changeMaster(master *game.NodeInfo) {
+	oldMaster := p.gameInfo.MasterNode()
+	if err := p.transitRole(master, takeOverTrigger); err != nil {
+		return
+	}
+	if oldMaster != nil && oldMaster.PlayerId() != master.PlayerId() {
+		_ = p.transitRole(oldMaster, leaveTrigger)
+	}
+}
+
+// Tells every node that the current node has become MASTER
+func (p *Peer) announceNewMaster() {
+	for playerId, node := range p.gameInfo.Nodes() {
+		if !node.IsMasterNode() && !node.IsLocalRobotNode() {
+			go p.sendRoleChangeMsg(
+				p.gameInfo.CurrentNode().PlayerId(),
+				playerId,
+				protocol.NodeRole_MASTER.Enum(),
+				nil,
+				node.Addr(),
+			)
+			node.UpdateTime(time.Now().Add(2 * p.gameInfo.StateDelay()))
+		}
+	}
+}
+
+// Redirects the pending messages when the MASTER has changed, whether the node has learnt it from the timeout,
+// RoleChangeMsg or StateMsg
+func (p *Peer) followMaster() {
+	master := p.gameInfo.MasterNode()
+	if master == nil || p.masterId.Swap(master.PlayerId()) == master.PlayerId() {
+		return
+	}
+	p.redirectPendingMsgs(master)
+}
+
+// Redirects the messages not acknowledged by the old MASTER to the new one, the new MASTER does not send them to
+// itself, so they are not delivered
+func (p *Peer) redirectPendingMsgs(master *game.NodeInfo) {
+	if master.PlayerId() == p.gameInfo.CurrentNode().PlayerId() {
+		for _, key := range p.retransmitQueue.discard(protocol.NodeRole_MASTER) {
+			p.correlator.fail(key)
+		}
+		return
+	}
+	for _, key := range p.retransmitQueue.retarget(protocol.NodeRole_MASTER, master.PlayerId(), master.Addr()) {
+		p.correlator.rekey(key, master.Addr())
+	}
+}
@@ -0,0 +1,502 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"p2p-snake/internal/p2p/game"
+	"p2p-snake/internal/p2p/protocol"
+	"p2p-snake/internal/util"
+)
+
+// Game of MASTER, DEPUTY, NORMAL and VIEWER seen by the node of the given role
+type roleTestGame struct {
+	peer   *Peer
+	remote *net.UDPConn // Sender of the messages, receives the responses
+	ids    map[protocol.NodeRole]int32
+}
+
+// Config of the 20x20 game with the default rules
+func newTestConfig() *protocol.GameConfig {
+	return &protocol.GameConfig{
+		Width:        proto.Int32(20),
+		Height:       proto.Int32(20),
+		FoodStatic:   proto.Int32(1),
+		StateDelayMs: proto.Int32(1000),
+	}
+}
+
+func newRoleTestGame(t *testing.T, currentRole protocol.NodeRole) *roleTestGame {
+	t.Helper()
+
+	remote, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	peer := NewPeer(&net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9}, time.Second, "")
+	peer.unicast, err = net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	peer.ctx, peer.cancel = context.WithCancel(context.Background())
+	peer.wg.Add(1)
+	go peer.retransmitMessages(peer.ctx)
+
+	peer.gameInfo = game.NewGameInfo()
+	if err := peer.gameInfo.CreateNewGame("test", newTestConfig()); err != nil {
+		t.Fatal(err)
+	}
+	ids := make(map[protocol.NodeRole]int32)
+	roles := []protocol.NodeRole{protocol.NodeRole_MASTER, protocol.NodeRole_DEPUTY, protocol.NodeRole_NORMAL,
+		protocol.NodeRole_VIEWER}
+	for port, role := range roles {
+		addr := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: port + 1}
+		node, _, err := peer.gameInfo.AddPlayer(role.String(), protocol.PlayerType_HUMAN, role, 0, addr, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[role] = node.PlayerId()
+		if role == currentRole {
+			node.SetAddr(nil)
+			peer.gameInfo.SetCurrentNode(node)
+		}
+	}
+	peer.followMaster()
+
+	t.Cleanup(func() {
+		peer.cancelGame()
+		peer.cancel()
+		peer.wg.Wait()
+		_ = peer.unicast.Close()
+		_ = remote.Close()
+	})
+	return &roleTestGame{peer: peer, remote: remote, ids: ids}
+}
+
+func (g *roleTestGame) role(t *testing.T, playerId int32) protocol.NodeRole {
+	t.Helper()
+	node, ok := g.peer.gameInfo.Node(playerId)
+	if !ok {
+		t.Fatalf("player %d not found", playerId)
+	}
+	return node.Role()
+}
+
+func (g *roleTestGame) snake(playerId int32) *protocol.GameState_Snake {
+	for _, snake := range g.peer.gameInfo.Snakes() {
+		if snake.GetPlayerId() == playerId {
+			return snake
+		}
+	}
+	return nil
+}
+
+func (g *roleTestGame) response(t *testing.T) *protocol.GameMessage {
+	t.Helper()
+	msg := &protocol.GameMessage{}
+	if _, err := util.ReceiveProto(msg, g.remote); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+func TestHandleRoleChangeMsg(t *testing.T) {
+	M, D, N, V := protocol.NodeRole_MASTER, protocol.NodeRole_DEPUTY, protocol.NodeRole_NORMAL, protocol.NodeRole_VIEWER
+
+	tests := []struct {
+		name         string
+		current      protocol.NodeRole
+		sender       protocol.NodeRole
+		senderRole   *protocol.NodeRole
+		receiverRole *protocol.NodeRole
+		want         map[protocol.NodeRole]protocol.NodeRole // Roles of the players after the message
+		wantDeleted  []protocol.NodeRole                     // Players deleted from the game after the message
+		wantError    bool
+	}{
+		{
+			name:       "1: DEPUTY reports that it has become MASTER",
+			current:    N,
+			sender:     D,
+			senderRole: M.Enum(),
+			want:       map[protocol.NodeRole]protocol.NodeRole{M: V, D: M, N: N},
+		},
+		{
+			name:        "2: NORMAL leaves the game",
+			current:     M,
+			sender:      N,
+			senderRole:  V.Enum(),
+			want:        map[protocol.NodeRole]protocol.NodeRole{M: M, D: D},
+			wantDeleted: []protocol.NodeRole{N},
+		},
+		{
+			name:       "2: MASTER leaves the game",
+			current:    N,
+			sender:     M,
+			senderRole: V.Enum(),
+			want:       map[protocol.NodeRole]protocol.NodeRole{M: V, D: D, N: N},
+		},
+		{
+			name:         "3: snake of NORMAL has died",
+			current:      N,
+			sender:       M,
+			receiverRole: V.Enum(),
+			want:         map[protocol.NodeRole]protocol.NodeRole{M: M, N: V},
+		},
+		{
+			name:         "3: snake of DEPUTY has died",
+			current:      D,
+			sender:       M,
+			receiverRole: V.Enum(),
+			want:         map[protocol.NodeRole]protocol.NodeRole{M: M, D: V},
+		},
+		{
+			name:         "4: NORMAL is appointed DEPUTY",
+			current:      N,
+			sender:       M,
+			receiverRole: D.Enum(),
+			want:         map[protocol.NodeRole]protocol.NodeRole{M: M, N: D},
+		},
+		{
+			name:         "4: resent appointment is acknowledged",
+			current:      D,
+			sender:       M,
+			receiverRole: D.Enum(),
+			want:         map[protocol.NodeRole]protocol.NodeRole{M: M, D: D},
+		},
+		{
+			name:         "5: leaving MASTER hands the game over to DEPUTY",
+			current:      D,
+			sender:       M,
+			senderRole:   V.Enum(),
+			receiverRole: M.Enum(),
+			want:         map[protocol.NodeRole]protocol.NodeRole{D: M},
+			wantDeleted:  []protocol.NodeRole{M},
+		},
+		{
+			name:         "snake of VIEWER has respawned",
+			current:      V,
+			sender:       M,
+			receiverRole: N.Enum(),
+			want:         map[protocol.NodeRole]protocol.NodeRole{M: M, V: N},
+		},
+		{
+			name:         "NORMAL cannot become MASTER",
+			current:      N,
+			sender:       M,
+			receiverRole: M.Enum(),
+			want:         map[protocol.NodeRole]protocol.NodeRole{M: M, N: N},
+			wantError:    true,
+		},
+		{
+			name:         "MASTER cannot die as VIEWER",
+			current:      M,
+			sender:       D,
+			receiverRole: V.Enum(),
+			want:         map[protocol.NodeRole]protocol.NodeRole{M: M, D: D},
+			wantError:    true,
+		},
+		{
+			name:         "VIEWER cannot become DEPUTY",
+			current:      V,
+			sender:       M,
+			receiverRole: D.Enum(),
+			want:         map[protocol.NodeRole]protocol.NodeRole{M: M, V: V},
+			wantError:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := newRoleTestGame(t, test.current)
+			msg := protocol.NewRoleChangeMsg(1, g.ids[test.sender], g.ids[test.current], test.senderRole,
+				test.receiverRole)
+			g.peer.handleRoleChangeMsg(msg, g.remote.LocalAddr().(*net.UDPAddr))
+
+			response := g.response(t)
+			if test.wantError && response.GetError() == nil {
+				t.Errorf("response = %v, want ErrorMsg", response)
+			}
+			if !test.wantError && response.GetAck() == nil {
+				t.Errorf("response = %v, want AckMsg", response)
+			}
+			for role, want := range test.want {
+				if got := g.role(t, g.ids[role]); got != want {
+					t.Errorf("role of %v = %v, want %v", role, got, want)
+				}
+			}
+			for _, role := range test.wantDeleted {
+				if _, ok := g.peer.gameInfo.Node(g.ids[role]); ok {
+					t.Errorf("%v is not deleted", role)
+				}
+				if snake := g.snake(g.ids[role]); snake == nil || snake.GetState() != protocol.GameState_Snake_ZOMBIE {
+					t.Errorf("snake of %v = %v, want ZOMBIE", role, snake)
+				}
+			}
+			if master := g.peer.gameInfo.MasterNode(); master != nil && master.PlayerId() != g.peer.masterId.Load() {
+				t.Errorf("messages follow %d, want MASTER %d", g.peer.masterId.Load(), master.PlayerId())
+			}
+		})
+	}
+}
+
+func TestMasterExpired(t *testing.T) {
+	tests := []struct {
+		name       string
+		current    protocol.NodeRole
+		withDeputy bool
+		wantMaster protocol.NodeRole // Player who is MASTER after the expiry
+		wantLeft   bool              // Current node has left the game
+	}{
+		{
+			name:       "DEPUTY takes over the game",
+			current:    protocol.NodeRole_DEPUTY,
+			withDeputy: true,
+			wantMaster: protocol.NodeRole_DEPUTY,
+		},
+		{
+			name:       "NORMAL follows DEPUTY",
+			current:    protocol.NodeRole_NORMAL,
+			withDeputy: true,
+			wantMaster: protocol.NodeRole_DEPUTY,
+		},
+		{
+			name:     "NORMAL leaves the game without DEPUTY",
+			current:  protocol.NodeRole_NORMAL,
+			wantLeft: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := newRoleTestGame(t, test.current)
+			if !test.withDeputy {
+				_ = g.peer.gameInfo.DeletePlayer(g.ids[protocol.NodeRole_DEPUTY])
+			}
+			// The new MASTER tells the others about itself
+			viewer, _ := g.peer.gameInfo.Node(g.ids[protocol.NodeRole_VIEWER])
+			viewer.SetAddr(g.remote.LocalAddr().(*net.UDPAddr))
+			info := g.peer.gameInfo
+			master := info.MasterNode()
+
+			g.peer.deleteMaster(master)
+
+			if test.wantLeft {
+				if g.peer.gameInfo != nil || g.peer.masterId.Load() != 0 {
+					t.Errorf("current node is in the game following %d, want it left", g.peer.masterId.Load())
+				}
+				return
+			}
+			if _, ok := info.Node(master.PlayerId()); ok {
+				t.Errorf("expired MASTER %d is not deleted", master.PlayerId())
+			}
+			newMaster := info.MasterNode()
+			if newMaster == nil || newMaster.PlayerId() != g.ids[test.wantMaster] {
+				t.Fatalf("MASTER = %v, want %v", newMaster, test.wantMaster)
+			}
+			if got := g.peer.masterId.Load(); got != newMaster.PlayerId() {
+				t.Errorf("messages follow %d, want %d", got, newMaster.PlayerId())
+			}
+			if info.CurrentNode().IsMasterNode() {
+				announcement := g.response(t)
+				if announcement.GetRoleChange().GetSenderRole() != protocol.NodeRole_MASTER {
+					t.Errorf("announcement = %v, want RoleChangeMsg from MASTER", announcement)
+				}
+			}
+		})
+	}
+}
+
+// The trigger not allowed for the role is refused, the node keeps its role and MASTER
+func TestTransitRoleIllegal(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    protocol.NodeRole
+		trigger roleTrigger
+	}{
+		{name: "MASTER cannot die as VIEWER", role: protocol.NodeRole_MASTER, trigger: deathTrigger},
+		{name: "MASTER cannot expire itself", role: protocol.NodeRole_MASTER, trigger: masterExpiredTrigger},
+		{name: "NORMAL cannot take over the game", role: protocol.NodeRole_NORMAL, trigger: takeOverTrigger},
+		{name: "NORMAL cannot be handed the game", role: protocol.NodeRole_NORMAL, trigger: handOverTrigger},
+		{name: "DEPUTY cannot respawn", role: protocol.NodeRole_DEPUTY, trigger: respawnTrigger},
+		{name: "VIEWER cannot become DEPUTY", role: protocol.NodeRole_VIEWER, trigger: appointDeputyTrigger},
+		{name: "VIEWER cannot leave twice", role: protocol.NodeRole_VIEWER, trigger: leaveTrigger},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := newRoleTestGame(t, protocol.NodeRole_MASTER)
+			node, _ := g.peer.gameInfo.Node(g.ids[test.role])
+
+			if err := g.peer.transitRole(node, test.trigger); err != illegalRoleTransitionError {
+				t.Errorf("transitRole() = %v, want %v", err, illegalRoleTransitionError)
+			}
+			if node.Role() != test.role {
+				t.Errorf("role = %v, want %v", node.Role(), test.role)
+			}
+			if got := g.peer.masterId.Load(); got != g.ids[protocol.NodeRole_MASTER] {
+				t.Errorf("messages follow %d, want MASTER %d", got, g.ids[protocol.NodeRole_MASTER])
+			}
+		})
+	}
+}
+
+func TestStateWithoutMaster(t *testing.T) {
+	g := newRoleTestGame(t, protocol.NodeRole_NORMAL)
+
+	// DEPUTY has taken over the game but has not reported it yet
+	state := g.peer.gameInfo.State()
+	players := make([]*protocol.GamePlayer, 0)
+	for _, player := range state.GetPlayers().GetPlayers() {
+		if player.GetId() != g.ids[protocol.NodeRole_MASTER] {
+			players = append(players, player)
+		}
+	}
+	state.Players = &protocol.GamePlayers{Players: players}
+	state.StateOrder = proto.Int32(state.GetStateOrder() + 1)
+
+	addr := g.remote.LocalAddr().(*net.UDPAddr)
+	g.peer.handleStateMsg(protocol.NewStateMsg(1, g.ids[protocol.NodeRole_DEPUTY], g.ids[protocol.NodeRole_NORMAL],
+		state), addr)
+	if response := g.response(t); response.GetAck() == nil {
+		t.Fatalf("response = %v, want AckMsg", response)
+	}
+
+	master := g.peer.gameInfo.MasterNode()
+	if master == nil || master.PlayerId() != g.ids[protocol.NodeRole_DEPUTY] {
+		t.Fatalf("MASTER = %v, want DEPUTY", master)
+	}
+	if master.Addr().String() != addr.String() {
+		t.Errorf("MASTER address = %v, want %v", master.Addr(), addr)
+	}
+	if got := g.peer.masterId.Load(); got != master.PlayerId() {
+		t.Errorf("messages follow %d, want %d", got, master.PlayerId())
+	}
+}
+
+// The appointment of DEPUTY does not hold up the game: NORMAL becomes DEPUTY when the acknowledgement comes
+func TestAppointDeputy(t *testing.T) {
+	g := newRoleTestGame(t, protocol.NodeRole_MASTER)
+	_ = g.peer.gameInfo.DeletePlayer(g.ids[protocol.NodeRole_DEPUTY])
+	normal, _ := g.peer.gameInfo.Node(g.ids[protocol.NodeRole_NORMAL])
+	viewer, _ := g.peer.gameInfo.Node(g.ids[protocol.NodeRole_VIEWER])
+	addr := g.remote.LocalAddr().(*net.UDPAddr)
+	normal.SetAddr(addr)
+
+	start := time.Now()
+	if !g.peer.appointDeputy(context.Background(), normal) {
+		t.Fatal("NORMAL is not appointed")
+	}
+	if elapsed := time.Since(start); elapsed > g.peer.gameInfo.StateDelay()/10 {
+		t.Errorf("appointment has taken %v, want no waiting for the acknowledgement", elapsed)
+	}
+	if g.peer.appointDeputy(context.Background(), viewer) {
+		t.Error("second DEPUTY is appointed before the first one has answered")
+	}
+	if normal.Role() != protocol.NodeRole_NORMAL {
+		t.Errorf("role before the acknowledgement = %v, want NORMAL", normal.Role())
+	}
+
+	request := g.response(t)
+	if request.GetRoleChange().GetReceiverRole() != protocol.NodeRole_DEPUTY {
+		t.Fatalf("request = %v, want RoleChangeMsg to DEPUTY", request)
+	}
+	g.peer.handleAckMsg(protocol.NewAckMsg(request.GetMsgSeq(), normal.PlayerId(), request.GetSenderId()), addr)
+	for deadline := time.Now().Add(time.Second); normal.Role() != protocol.NodeRole_DEPUTY; {
+		if time.Now().After(deadline) {
+			t.Fatalf("role after the acknowledgement = %v, want DEPUTY", normal.Role())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// The players whose snakes have died get new snakes in the next round and are told that they are NORMAL again
+func TestRestartRoundRespawnsViewers(t *testing.T) {
+	g := newRoleTestGame(t, protocol.NodeRole_MASTER)
+	normal, _ := g.peer.gameInfo.Node(g.ids[protocol.NodeRole_NORMAL])
+	viewer, _ := g.peer.gameInfo.Node(g.ids[protocol.NodeRole_VIEWER])
+	addr := g.remote.LocalAddr().(*net.UDPAddr)
+	normal.SetAddr(addr)
+	if err := g.peer.transitRole(normal, deathTrigger); err != nil {
+		t.Fatal(err)
+	}
+	g.peer.gameInfo.SetResult(&protocol.GameResult{Reason: protocol.GameResult_TURN_LIMIT.Enum()})
+
+	if err := g.peer.RestartRound(true); err != nil {
+		t.Fatal(err)
+	}
+
+	request := g.response(t)
+	if request.GetReceiverId() != normal.PlayerId() ||
+		request.GetRoleChange().GetReceiverRole() != protocol.NodeRole_NORMAL {
+		t.Fatalf("request = %v, want RoleChangeMsg to NORMAL", request)
+	}
+	if normal.Role() != protocol.NodeRole_NORMAL {
+		t.Errorf("role of the respawned player = %v, want NORMAL", normal.Role())
+	}
+	if viewer.Role() != protocol.NodeRole_VIEWER || g.snake(viewer.PlayerId()) != nil {
+		t.Errorf("spectator is %v with snake %v, want VIEWER without a snake", viewer.Role(),
+			g.snake(viewer.PlayerId()))
+	}
+}
+
+// The message not acknowledged by the old MASTER is delivered to the new one, whether the node learns about the new
+// MASTER from its RoleChangeMsg or from the expiry of the old MASTER
+func TestRedirectPendingMsgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		announce bool // New MASTER tells the node about itself
+	}{
+		{name: "new MASTER announces itself", announce: true},
+		{name: "old MASTER expires", announce: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := newRoleTestGame(t, protocol.NodeRole_NORMAL)
+			g.listen()
+			deputy := g.newSecondPeer(t, protocol.NodeRole_DEPUTY)
+			normalId, masterId, deputyId := g.ids[protocol.NodeRole_NORMAL], g.ids[protocol.NodeRole_MASTER],
+				g.ids[protocol.NodeRole_DEPUTY]
+
+			// The old MASTER does not answer anymore
+			master, _ := g.peer.gameInfo.Node(masterId)
+			results := make(chan deliveryResult, 1)
+			go func() {
+				results <- g.peer.sendSteerMsg(normalId, masterId, protocol.Direction_LEFT, master.Addr())
+			}()
+			for deadline := time.Now().Add(time.Second); len(queuedKeys(g.peer.retransmitQueue)) == 0; {
+				if time.Now().After(deadline) {
+					t.Fatal("SteerMsg is not sent")
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			if !test.announce {
+				normal, _ := deputy.gameInfo.Node(normalId)
+				normal.SetAddr(nil)
+			}
+			oldMaster, _ := deputy.gameInfo.Node(masterId)
+			deputy.deleteMaster(oldMaster)
+			if !test.announce {
+				g.peer.deleteMaster(master)
+			}
+
+			select {
+			case result := <-results:
+				if result.err != nil || result.response.GetAck() == nil || result.response.GetSenderId() != deputyId {
+					t.Errorf("SteerMsg result = %v, %v, want AckMsg from the new MASTER %d", result.response,
+						result.err, deputyId)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("SteerMsg is not answered")
+			}
+			if got := g.peer.masterId.Load(); got != deputyId {
+				t.Errorf("messages follow %d, want the new MASTER %d", got, deputyId)
+			}
+		})
+	}
+}